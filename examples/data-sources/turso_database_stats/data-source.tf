@@ -0,0 +1,8 @@
+data "turso_database_stats" "example" {
+  organization_name = "jpedroh"
+  database_name     = "my-database"
+}
+
+output "top_queries" {
+  value = [for query in data.turso_database_stats.example.top_queries : query.query]
+}
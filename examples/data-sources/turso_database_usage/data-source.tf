@@ -0,0 +1,10 @@
+data "turso_database_usage" "example" {
+  organization_name = "jpedroh"
+  database_name     = "my-database"
+  from              = "2024-01-01T00:00:00Z"
+  to                = "2024-02-01T00:00:00Z"
+}
+
+output "rows_read" {
+  value = data.turso_database_usage.example.total.rows_read
+}
@@ -0,0 +1,17 @@
+# Database and token creations of the last day, for review.
+data "turso_organization_audit_logs" "example" {
+  organization_name = "jpedroh"
+  codes             = ["db-create", "db-token-create"]
+  since             = timeadd(plantimestamp(), "-24h")
+  max_results       = 500
+}
+
+output "recent_events" {
+  value = [
+    for entry in data.turso_organization_audit_logs.example.audit_logs : {
+      author  = entry.author
+      message = entry.message
+      data    = jsondecode(entry.data)
+    }
+  ]
+}
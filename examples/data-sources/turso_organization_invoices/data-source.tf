@@ -0,0 +1,11 @@
+data "turso_organization_invoices" "example" {
+  organization_name = "jpedroh"
+  type              = "issued"
+}
+
+output "unpaid_invoices" {
+  value = [
+    for invoice in data.turso_organization_invoices.example.invoices : invoice.invoice_number
+    if invoice.paid_at == null
+  ]
+}
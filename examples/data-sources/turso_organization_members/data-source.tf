@@ -0,0 +1,3 @@
+data "turso_organization_members" "example" {
+  organization_name = "an-organization"
+}
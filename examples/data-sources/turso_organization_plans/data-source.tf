@@ -0,0 +1,15 @@
+data "turso_organization_subscription" "example" {
+  organization_name = "jpedroh"
+}
+
+data "turso_organization_plans" "example" {
+  organization_name = "jpedroh"
+}
+
+# The monthly price of the current plan.
+output "monthly_price" {
+  value = one([
+    for plan in data.turso_organization_plans.example.plans : plan.price
+    if plan.name == data.turso_organization_subscription.example.plan
+  ])
+}
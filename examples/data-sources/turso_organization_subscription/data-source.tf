@@ -0,0 +1,3 @@
+data "turso_organization_subscription" "example" {
+  organization_name = "jpedroh"
+}
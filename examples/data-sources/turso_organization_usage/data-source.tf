@@ -0,0 +1,13 @@
+# Fails the plan once rows written or storage reach 90% of the plan quotas.
+data "turso_organization_usage" "example" {
+  organization_name = "jpedroh"
+
+  fail_above_percent = {
+    rows_written  = 90
+    storage_bytes = 90
+  }
+}
+
+output "rows_written_percent" {
+  value = data.turso_organization_usage.example.percent_used["rows_written"]
+}
@@ -0,0 +1,7 @@
+data "turso_organizations" "all" {}
+
+data "turso_organization_members" "each" {
+  for_each = { for organization in data.turso_organizations.all.organizations : organization.slug => organization }
+
+  organization_name = each.key
+}
@@ -0,0 +1,6 @@
+ephemeral "turso_database_token" "example" {
+  organization_name = "an-organization"
+  database_name     = "a-database"
+  authorization     = "read-only"
+  expiration        = "30m"
+}
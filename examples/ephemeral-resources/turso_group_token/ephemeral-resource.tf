@@ -0,0 +1,12 @@
+ephemeral "turso_group_token" "example" {
+  organization_name = "an-organization"
+  group_name        = "a-group"
+  expiration        = "4w"
+}
+
+# Write-only arguments accept ephemeral values and are never stored in state.
+resource "aws_secretsmanager_secret_version" "example" {
+  secret_id                = aws_secretsmanager_secret.turso.id
+  secret_string_wo         = ephemeral.turso_group_token.example.jwt
+  secret_string_wo_version = 1
+}
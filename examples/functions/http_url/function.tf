@@ -0,0 +1,3 @@
+output "database_http_url" {
+  value = provider::turso::http_url(turso_database.example.hostname)
+}
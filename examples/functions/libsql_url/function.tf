@@ -0,0 +1,4 @@
+output "database_url" {
+  value     = provider::turso::libsql_url(turso_database.example.hostname, turso_database_token.example.jwt)
+  sensitive = true
+}
@@ -0,0 +1,7 @@
+locals {
+  database = provider::turso::parse_libsql_url(var.database_url)
+}
+
+output "database_hostname" {
+  value = local.database.hostname
+}
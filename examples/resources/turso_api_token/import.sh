@@ -0,0 +1 @@
+terraform import turso_api_token.example name
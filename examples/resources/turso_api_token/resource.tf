@@ -0,0 +1,3 @@
+resource "turso_api_token" "example" {
+  name = "ci"
+}
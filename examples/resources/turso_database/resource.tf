@@ -2,4 +2,18 @@ resource "turso_database" "example" {
   organization_name = "an-organization"
   group             = "a-group"
   name              = "a-database"
+  delete_protection = true
+}
+
+# A shared schema database and a tenant database that follows its schema.
+resource "turso_database" "schema" {
+  organization_name = "an-organization"
+  name              = "tenant-schema"
+  is_schema         = true
+}
+
+resource "turso_database" "tenant" {
+  organization_name = "an-organization"
+  name              = "tenant-acme"
+  schema            = turso_database.schema.name
 }
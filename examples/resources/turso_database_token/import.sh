@@ -0,0 +1,5 @@
+# The JWT can be passed in the identifier...
+terraform import turso_database_token.example organization_name/database_name/eyJhbGciOiJFZERTQSIs...
+
+# ...or in the TURSO_IMPORT_DATABASE_TOKEN environment variable.
+TURSO_IMPORT_DATABASE_TOKEN=eyJhbGciOiJFZERTQSIs... terraform import turso_database_token.example organization_name/database_name
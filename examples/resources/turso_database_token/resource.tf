@@ -0,0 +1,16 @@
+resource "turso_database_token" "example" {
+  organization_name = "an-organization"
+  database_name     = "a-database"
+  expiration        = "2w"
+  authorization     = "read-only"
+}
+
+# Deliver the token to a local env file instead of storing it in state.
+resource "turso_database_token" "env_file" {
+  organization_name      = "an-organization"
+  database_name          = "a-database"
+  store_jwt_in_state     = false
+  output_file            = "${path.module}/.env.turso"
+  output_format          = "env"
+  output_file_permission = "0600"
+}
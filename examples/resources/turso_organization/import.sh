@@ -0,0 +1 @@
+terraform import turso_organization.example slug
@@ -0,0 +1,4 @@
+resource "turso_organization" "example" {
+  slug     = "an-organization"
+  overages = true
+}
@@ -0,0 +1 @@
+terraform import turso_organization_invite.example organization_name/email
@@ -0,0 +1,5 @@
+resource "turso_organization_invite" "example" {
+  organization_name = "an-organization"
+  email             = "new-hire@example.com"
+  role              = "member"
+}
@@ -0,0 +1 @@
+terraform import turso_organization_member.example organization_name/username
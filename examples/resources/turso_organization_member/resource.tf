@@ -0,0 +1,5 @@
+resource "turso_organization_member" "example" {
+  organization_name = "an-organization"
+  username          = "a-user"
+  role              = "member"
+}
@@ -0,0 +1 @@
+terraform import turso_organization_members.example organization_name
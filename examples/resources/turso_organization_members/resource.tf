@@ -0,0 +1,9 @@
+resource "turso_organization_members" "example" {
+  organization_name = "an-organization"
+
+  members = {
+    alice = "admin"
+    bob   = "member"
+    carol = "viewer"
+  }
+}
@@ -589,7 +589,7 @@ func (s *AuditLog) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		if s.Data != nil {
+		if s.Data.Set {
 			e.FieldStart("data")
 			s.Data.Encode(e)
 		}
@@ -665,12 +665,10 @@ func (s *AuditLog) Decode(d *jx.Decoder) error {
 			}
 		case "data":
 			if err := func() error {
-				s.Data = nil
-				var elem AuditLogData
-				if err := elem.Decode(d); err != nil {
+				s.Data.Reset()
+				if err := s.Data.Decode(d); err != nil {
 					return err
 				}
-				s.Data = &elem
 				return nil
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"data\"")
@@ -784,29 +782,43 @@ func (s *AuditLogCode) UnmarshalJSON(data []byte) error {
 }
 
 // Encode implements json.Marshaler.
-func (s *AuditLogData) Encode(e *jx.Encoder) {
+func (s AuditLogData) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *AuditLogData) encodeFields(e *jx.Encoder) {
-}
+// encodeFields implements json.Marshaler.
+func (s AuditLogData) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
 
-var jsonFieldsNameOfAuditLogData = [0]string{}
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
 
 // Decode decodes AuditLogData from json.
 func (s *AuditLogData) Decode(d *jx.Decoder) error {
 	if s == nil {
 		return errors.New("invalid: unable to decode AuditLogData to nil")
 	}
-
+	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		default:
-			return d.Skip()
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
+		return nil
 	}); err != nil {
 		return errors.Wrap(err, "decode AuditLogData")
 	}
@@ -815,7 +827,7 @@ func (s *AuditLogData) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *AuditLogData) MarshalJSON() ([]byte, error) {
+func (s AuditLogData) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
@@ -982,13 +994,27 @@ func (s *CreateDatabaseInput) encodeFields(e *jx.Encoder) {
 			s.SizeLimit.Encode(e)
 		}
 	}
+	{
+		if s.IsSchema.Set {
+			e.FieldStart("is_schema")
+			s.IsSchema.Encode(e)
+		}
+	}
+	{
+		if s.Schema.Set {
+			e.FieldStart("schema")
+			s.Schema.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfCreateDatabaseInput = [4]string{
+var jsonFieldsNameOfCreateDatabaseInput = [6]string{
 	0: "name",
 	1: "group",
 	2: "seed",
 	3: "size_limit",
+	4: "is_schema",
+	5: "schema",
 }
 
 // Decode decodes CreateDatabaseInput from json.
@@ -1044,6 +1070,26 @@ func (s *CreateDatabaseInput) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"size_limit\"")
 			}
+		case "is_schema":
+			if err := func() error {
+				s.IsSchema.Reset()
+				if err := s.IsSchema.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_schema\"")
+			}
+		case "schema":
+			if err := func() error {
+				s.Schema.Reset()
+				if err := s.Schema.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"schema\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -2044,6 +2090,18 @@ func (s *Database) encodeFields(e *jx.Encoder) {
 			s.DeleteProtection.Encode(e)
 		}
 	}
+	{
+		if s.IsSchema.Set {
+			e.FieldStart("is_schema")
+			s.IsSchema.Encode(e)
+		}
+	}
+	{
+		if s.Schema.Set {
+			e.FieldStart("schema")
+			s.Schema.Encode(e)
+		}
+	}
 	{
 		if s.Parent.Set {
 			e.FieldStart("parent")
@@ -2052,17 +2110,19 @@ func (s *Database) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfDatabase = [10]string{
-	0: "Name",
-	1: "DbId",
-	2: "Hostname",
-	3: "block_reads",
-	4: "block_writes",
-	5: "regions",
-	6: "primaryRegion",
-	7: "group",
-	8: "delete_protection",
-	9: "parent",
+var jsonFieldsNameOfDatabase = [12]string{
+	0:  "Name",
+	1:  "DbId",
+	2:  "Hostname",
+	3:  "block_reads",
+	4:  "block_writes",
+	5:  "regions",
+	6:  "primaryRegion",
+	7:  "group",
+	8:  "delete_protection",
+	9:  "is_schema",
+	10: "schema",
+	11: "parent",
 }
 
 // Decode decodes Database from json.
@@ -2172,6 +2232,26 @@ func (s *Database) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"delete_protection\"")
 			}
+		case "is_schema":
+			if err := func() error {
+				s.IsSchema.Reset()
+				if err := s.IsSchema.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"is_schema\"")
+			}
+		case "schema":
+			if err := func() error {
+				s.Schema.Reset()
+				if err := s.Schema.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"schema\"")
+			}
 		case "parent":
 			if err := func() error {
 				s.Parent.Reset()
@@ -7047,6 +7127,40 @@ func (s *OptAuditLogCode) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes AuditLogData as json.
+func (o OptAuditLogData) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes AuditLogData from json.
+func (o *OptAuditLogData) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptAuditLogData to nil")
+	}
+	o.Set = true
+	o.Value = make(AuditLogData)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptAuditLogData) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptAuditLogData) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes bool as json.
 func (o OptBool) Encode(e *jx.Encoder) {
 	if !o.Set {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
 )
 
 // Ref: #/components/schemas/APIToken
@@ -221,7 +222,7 @@ type AuditLog struct {
 	// A formatted [ISO 8601](https://en.wikipedia.org/wiki/ISO_8601) timestamp this action was performed.
 	CreatedAt OptString `json:"created_at"`
 	// The payload of the action performed.
-	Data *AuditLogData `json:"data"`
+	Data OptAuditLogData `json:"data"`
 }
 
 // GetCode returns the value of Code.
@@ -250,7 +251,7 @@ func (s *AuditLog) GetCreatedAt() OptString {
 }
 
 // GetData returns the value of Data.
-func (s *AuditLog) GetData() *AuditLogData {
+func (s *AuditLog) GetData() OptAuditLogData {
 	return s.Data
 }
 
@@ -280,7 +281,7 @@ func (s *AuditLog) SetCreatedAt(val OptString) {
 }
 
 // SetData sets the value of Data.
-func (s *AuditLog) SetData(val *AuditLogData) {
+func (s *AuditLog) SetData(val OptAuditLogData) {
 	s.Data = val
 }
 
@@ -481,7 +482,16 @@ func (s *AuditLogCode) UnmarshalText(data []byte) error {
 }
 
 // The payload of the action performed.
-type AuditLogData struct{}
+type AuditLogData map[string]jx.Raw
+
+func (s *AuditLogData) init() AuditLogData {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
 
 type CreateDatabaseBadRequest struct {
 	// The error message.
@@ -528,6 +538,11 @@ type CreateDatabaseInput struct {
 	// The maximum size of the database in bytes. Values with units are also accepted, e.g. 1mb, 256mb,
 	// 1gb.
 	SizeLimit OptString `json:"size_limit"`
+	// Mark this database as the parent schema database that updates child databases with any schema
+	// changes.
+	IsSchema OptBool `json:"is_schema"`
+	// The name of the parent database to use as the schema.
+	Schema OptString `json:"schema"`
 }
 
 // GetName returns the value of Name.
@@ -550,6 +565,16 @@ func (s *CreateDatabaseInput) GetSizeLimit() OptString {
 	return s.SizeLimit
 }
 
+// GetIsSchema returns the value of IsSchema.
+func (s *CreateDatabaseInput) GetIsSchema() OptBool {
+	return s.IsSchema
+}
+
+// GetSchema returns the value of Schema.
+func (s *CreateDatabaseInput) GetSchema() OptString {
+	return s.Schema
+}
+
 // SetName sets the value of Name.
 func (s *CreateDatabaseInput) SetName(val string) {
 	s.Name = val
@@ -570,6 +595,16 @@ func (s *CreateDatabaseInput) SetSizeLimit(val OptString) {
 	s.SizeLimit = val
 }
 
+// SetIsSchema sets the value of IsSchema.
+func (s *CreateDatabaseInput) SetIsSchema(val OptBool) {
+	s.IsSchema = val
+}
+
+// SetSchema sets the value of Schema.
+func (s *CreateDatabaseInput) SetSchema(val OptString) {
+	s.Schema = val
+}
+
 type CreateDatabaseInputSeed struct {
 	// The type of seed to be used to create a new database.
 	Type OptCreateDatabaseInputSeedType `json:"type"`
@@ -958,8 +993,12 @@ type Database struct {
 	// The name of the group the database belongs to.
 	Group OptString `json:"group"`
 	// The current status for delete protection. If enabled, the database cannot be deleted.
-	DeleteProtection OptBool              `json:"delete_protection"`
-	Parent           OptNilDatabaseParent `json:"parent"`
+	DeleteProtection OptBool `json:"delete_protection"`
+	// Whether this database is a parent schema database.
+	IsSchema OptBool `json:"is_schema"`
+	// The name of the parent schema database, if this database is a child of one.
+	Schema OptString            `json:"schema"`
+	Parent OptNilDatabaseParent `json:"parent"`
 }
 
 // GetName returns the value of Name.
@@ -1007,6 +1046,16 @@ func (s *Database) GetDeleteProtection() OptBool {
 	return s.DeleteProtection
 }
 
+// GetIsSchema returns the value of IsSchema.
+func (s *Database) GetIsSchema() OptBool {
+	return s.IsSchema
+}
+
+// GetSchema returns the value of Schema.
+func (s *Database) GetSchema() OptString {
+	return s.Schema
+}
+
 // GetParent returns the value of Parent.
 func (s *Database) GetParent() OptNilDatabaseParent {
 	return s.Parent
@@ -1057,6 +1106,16 @@ func (s *Database) SetDeleteProtection(val OptBool) {
 	s.DeleteProtection = val
 }
 
+// SetIsSchema sets the value of IsSchema.
+func (s *Database) SetIsSchema(val OptBool) {
+	s.IsSchema = val
+}
+
+// SetSchema sets the value of Schema.
+func (s *Database) SetSchema(val OptString) {
+	s.Schema = val
+}
+
 // SetParent sets the value of Parent.
 func (s *Database) SetParent(val OptNilDatabaseParent) {
 	s.Parent = val
@@ -3152,6 +3211,52 @@ func (o OptAuditLogCode) Or(d AuditLogCode) AuditLogCode {
 	return d
 }
 
+// NewOptAuditLogData returns new OptAuditLogData with value set to v.
+func NewOptAuditLogData(v AuditLogData) OptAuditLogData {
+	return OptAuditLogData{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAuditLogData is optional AuditLogData.
+type OptAuditLogData struct {
+	Value AuditLogData
+	Set   bool
+}
+
+// IsSet returns true if OptAuditLogData was set.
+func (o OptAuditLogData) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAuditLogData) Reset() {
+	var v AuditLogData
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAuditLogData) SetTo(v AuditLogData) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAuditLogData) Get() (v AuditLogData, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAuditLogData) Or(d AuditLogData) AuditLogData {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptBool returns new OptBool with value set to v.
 func NewOptBool(v bool) OptBool {
 	return OptBool{
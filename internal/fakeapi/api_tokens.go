@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeapi
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"terraform-provider-turso/internal/client"
+
+	"github.com/go-faster/jx"
+)
+
+func (h *Handler) ListAPITokens(ctx context.Context) (*client.ListAPITokensOK, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	tokens := []client.APIToken{}
+	for _, token := range h.apiTokens {
+		tokens = append(tokens, *token)
+	}
+
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].Name.Value < tokens[j].Name.Value
+	})
+
+	return &client.ListAPITokensOK{Tokens: tokens}, nil
+}
+
+func (h *Handler) CreateAPIToken(ctx context.Context, params client.CreateAPITokenParams) (jx.Raw, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.apiTokens[params.TokenName]; ok {
+		return nil, &Error{StatusCode: http.StatusConflict, Message: "a token with this name already exists"}
+	}
+
+	token := &client.APIToken{
+		Name: client.NewOptString(params.TokenName),
+		ID:   client.NewOptString(h.uuid()),
+	}
+
+	jwt, err := newJWT(token.ID.Value, "full-access", "")
+	if err != nil {
+		return nil, err
+	}
+
+	h.apiTokens[params.TokenName] = token
+
+	e := &jx.Encoder{}
+	e.ObjStart()
+	e.FieldStart("name")
+	e.Str(token.Name.Value)
+	e.FieldStart("id")
+	e.Str(token.ID.Value)
+	e.FieldStart("token")
+	e.Str(jwt)
+	e.ObjEnd()
+
+	return e.Bytes(), nil
+}
+
+func (h *Handler) RevokeAPIToken(ctx context.Context, params client.RevokeAPITokenParams) (jx.Raw, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.apiTokens[params.TokenName]; !ok {
+		return nil, notFound("token %s not found", params.TokenName)
+	}
+
+	delete(h.apiTokens, params.TokenName)
+
+	e := &jx.Encoder{}
+	e.ObjStart()
+	e.FieldStart("token")
+	e.Str(params.TokenName)
+	e.ObjEnd()
+
+	return e.Bytes(), nil
+}
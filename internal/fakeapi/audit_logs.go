@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeapi
+
+import (
+	"context"
+	"terraform-provider-turso/internal/client"
+)
+
+func (h *Handler) ListOrganizationAuditLogs(ctx context.Context, params client.ListOrganizationAuditLogsParams) (*client.ListOrganizationAuditLogsOK, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(params.OrganizationSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := params.PageSize.Or(100)
+	page := params.Page.Or(1)
+	if pageSize < 1 || page < 1 {
+		return nil, badRequest("page and page_size must be positive")
+	}
+
+	total := len(org.auditLogs)
+	res := &client.ListOrganizationAuditLogsOK{
+		AuditLogs: []client.AuditLog{},
+		Pagination: client.NewOptListOrganizationAuditLogsOKPagination(client.ListOrganizationAuditLogsOKPagination{
+			Page:       client.NewOptInt(page),
+			PageSize:   client.NewOptInt(pageSize),
+			TotalPages: client.NewOptInt((total + pageSize - 1) / pageSize),
+			TotalRows:  client.NewOptInt(total),
+		}),
+	}
+
+	// The newest entries come first.
+	for i := (page - 1) * pageSize; i < page*pageSize && i < total; i++ {
+		res.AuditLogs = append(res.AuditLogs, org.auditLogs[total-1-i])
+	}
+
+	return res, nil
+}
+
+// AddAuditLog seeds an audit log entry, which must be newer than the entries
+// seeded before it.
+func (h *Handler) AddAuditLog(organizationSlug string, auditLog client.AuditLog) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(organizationSlug)
+	if err != nil {
+		return err
+	}
+
+	org.auditLogs = append(org.auditLogs, auditLog)
+
+	return nil
+}
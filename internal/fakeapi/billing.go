@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeapi
+
+import (
+	"context"
+	"terraform-provider-turso/internal/client"
+)
+
+func (h *Handler) GetOrganizationSubscription(ctx context.Context, params client.GetOrganizationSubscriptionParams) (*client.GetOrganizationSubscriptionOK, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(params.OrganizationSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.GetOrganizationSubscriptionOK{Subscription: client.NewOptGetOrganizationSubscriptionOKSubscription(client.GetOrganizationSubscriptionOKSubscription{
+		Name:     org.organization.PlanID,
+		Overages: org.organization.Overages,
+		Plan:     org.organization.PlanID,
+		Timeline: org.organization.PlanTimeline,
+	})}, nil
+}
+
+func (h *Handler) ListOrganizationInvoices(ctx context.Context, params client.ListOrganizationInvoicesParams) (*client.ListOrganizationInvoicesOK, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(params.OrganizationSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &client.ListOrganizationInvoicesOK{Invoices: []client.ListOrganizationInvoicesOKInvoicesItem{}}
+	for _, invoice := range org.invoices {
+		switch params.Type.Or(client.ListOrganizationInvoicesTypeAll) {
+		case client.ListOrganizationInvoicesTypeUpcoming:
+			if !invoice.upcoming {
+				continue
+			}
+		case client.ListOrganizationInvoicesTypeIssued:
+			if invoice.upcoming {
+				continue
+			}
+		}
+
+		res.Invoices = append(res.Invoices, invoice.invoice)
+	}
+
+	return res, nil
+}
+
+// AddInvoice seeds an invoice. Upcoming invoices have not been issued yet.
+func (h *Handler) AddInvoice(organizationSlug string, item client.ListOrganizationInvoicesOKInvoicesItem, upcoming bool) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(organizationSlug)
+	if err != nil {
+		return err
+	}
+
+	org.invoices = append(org.invoices, invoice{invoice: item, upcoming: upcoming})
+
+	return nil
+}
+
+// SetOrganizationPlan subscribes the organization to plan, which does not have
+// to be one of the plans the fake lists.
+func (h *Handler) SetOrganizationPlan(organizationSlug, plan string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(organizationSlug)
+	if err != nil {
+		return err
+	}
+
+	org.organization.PlanID = client.NewOptString(plan)
+
+	return nil
+}
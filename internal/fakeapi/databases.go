@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"terraform-provider-turso/internal/client"
+	"time"
+)
+
+func (h *Handler) ListDatabases(ctx context.Context, params client.ListDatabasesParams) (*client.ListDatabasesOK, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(params.OrganizationSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	databases := []client.Database{}
+	for _, db := range org.databases {
+		if params.Group.Set && db.database.Group.Value != params.Group.Value {
+			continue
+		}
+		if params.Schema.Set && db.database.Schema.Value != params.Schema.Value {
+			continue
+		}
+		databases = append(databases, db.database)
+	}
+
+	sort.Slice(databases, func(i, j int) bool {
+		return databases[i].Name.Value < databases[j].Name.Value
+	})
+
+	return &client.ListDatabasesOK{Databases: databases}, nil
+}
+
+func (h *Handler) GetDatabase(ctx context.Context, params client.GetDatabaseParams) (client.GetDatabaseRes, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	db, err := h.database(params.OrganizationSlug, params.DatabaseName)
+	if err != nil {
+		return &client.DatabaseNotFoundResponse{Error: client.NewOptString(err.Error())}, nil
+	}
+
+	return &client.GetDatabaseOK{Database: client.NewOptDatabase(db.database)}, nil
+}
+
+func (h *Handler) CreateDatabase(ctx context.Context, req *client.CreateDatabaseInput, params client.CreateDatabaseParams) (client.CreateDatabaseRes, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(params.OrganizationSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := org.databases[req.Name]; ok {
+		return &client.CreateDatabaseConflict{Error: client.NewOptString(fmt.Sprintf("database %s already exists", req.Name))}, nil
+	}
+
+	if req.IsSchema.Value && req.Schema.Value != "" {
+		return &client.CreateDatabaseBadRequest{Error: client.NewOptString("a schema database cannot use another schema")}, nil
+	}
+
+	if req.Schema.Value != "" {
+		parent, ok := org.databases[req.Schema.Value]
+		if !ok || !parent.database.IsSchema.Value {
+			return &client.CreateDatabaseBadRequest{Error: client.NewOptString(fmt.Sprintf("schema database %s does not exist", req.Schema.Value))}, nil
+		}
+	}
+
+	var parent *database
+	if req.Seed.Value.Type.Value == client.CreateDatabaseInputSeedTypeDatabase {
+		var ok bool
+		if parent, ok = org.databases[req.Seed.Value.Name.Value]; !ok {
+			return &client.CreateDatabaseBadRequest{Error: client.NewOptString(fmt.Sprintf("seed database %s does not exist", req.Seed.Value.Name.Value))}, nil
+		}
+	}
+
+	db, err := h.newDatabase(org, req.Name, req.Group)
+	if err != nil {
+		return &client.CreateDatabaseBadRequest{Error: client.NewOptString(err.Error())}, nil
+	}
+
+	if parent != nil {
+		db.database.Parent = client.NewOptNilDatabaseParent(client.DatabaseParent{
+			ID:         parent.database.DbId,
+			Name:       parent.database.Name,
+			BranchedAt: client.NewOptDateTime(time.Now().UTC()),
+		})
+	}
+
+	if req.SizeLimit.Value != "" {
+		db.configuration.SizeLimit = req.SizeLimit
+	}
+	db.database.IsSchema = client.NewOptBool(req.IsSchema.Value)
+	if req.Schema.Value != "" {
+		db.database.Schema = req.Schema
+	}
+	org.databases[req.Name] = db
+
+	return &client.CreateDatabaseOK{Database: client.NewOptCreateDatabaseOutput(client.CreateDatabaseOutput{
+		DbId:     client.NewOptDbId(client.DbId(db.database.DbId.Value)),
+		Hostname: client.NewOptHostname(client.Hostname(db.database.Hostname.Value)),
+		Name:     client.NewOptName(client.Name(req.Name)),
+	})}, nil
+}
+
+func (h *Handler) DeleteDatabase(ctx context.Context, params client.DeleteDatabaseParams) (client.DeleteDatabaseRes, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	db, err := h.database(params.OrganizationSlug, params.DatabaseName)
+	if err != nil {
+		return &client.DatabaseNotFoundResponse{Error: client.NewOptString(err.Error())}, nil
+	}
+
+	if db.configuration.DeleteProtection.Value {
+		return nil, badRequest("database %s has delete protection enabled", params.DatabaseName)
+	}
+
+	for _, child := range h.organizations[params.OrganizationSlug].databases {
+		if child.database.Schema.Value == params.DatabaseName {
+			return nil, badRequest("schema database %s is still used by %s", params.DatabaseName, child.database.Name.Value)
+		}
+	}
+
+	delete(h.organizations[params.OrganizationSlug].databases, params.DatabaseName)
+
+	return &client.DeleteDatabaseOK{Database: client.NewOptString(params.DatabaseName)}, nil
+}
+
+func (h *Handler) ListDatabaseInstances(ctx context.Context, params client.ListDatabaseInstancesParams) (*client.ListDatabaseInstancesOK, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	db, err := h.database(params.OrganizationSlug, params.DatabaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.ListDatabaseInstancesOK{Instances: append([]client.Instance{}, db.instances...)}, nil
+}
+
+func (h *Handler) GetDatabaseInstance(ctx context.Context, params client.GetDatabaseInstanceParams) (*client.GetDatabaseInstanceOK, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	db, err := h.database(params.OrganizationSlug, params.DatabaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, instance := range db.instances {
+		if instance.Name.Value == params.InstanceName {
+			return &client.GetDatabaseInstanceOK{Instance: client.NewOptInstance(instance)}, nil
+		}
+	}
+
+	return nil, notFound("instance %s not found", params.InstanceName)
+}
+
+func (h *Handler) GetDatabaseConfiguration(ctx context.Context, params client.GetDatabaseConfigurationParams) (*client.DatabaseConfigurationResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	db, err := h.database(params.OrganizationSlug, params.DatabaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	configuration := db.configuration
+	return &configuration, nil
+}
+
+func (h *Handler) UpdateDatabaseConfiguration(ctx context.Context, req *client.DatabaseConfigurationInput, params client.UpdateDatabaseConfigurationParams) (*client.DatabaseConfigurationResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	db, err := h.database(params.OrganizationSlug, params.DatabaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Like the real API, only the fields present in the request are updated.
+	if req.SizeLimit.Set {
+		db.configuration.SizeLimit = req.SizeLimit
+	}
+	if req.AllowAttach.Set {
+		db.configuration.AllowAttach = req.AllowAttach
+	}
+	if req.BlockReads.Set {
+		db.configuration.BlockReads = req.BlockReads
+	}
+	if req.BlockWrites.Set {
+		db.configuration.BlockWrites = req.BlockWrites
+	}
+	if req.DeleteProtection.Set {
+		db.configuration.DeleteProtection = req.DeleteProtection
+	}
+	db.syncConfiguration()
+
+	configuration := db.configuration
+	return &configuration, nil
+}
+
+func (h *Handler) CreateDatabaseToken(ctx context.Context, req client.OptCreateTokenInput, params client.CreateDatabaseTokenParams) (client.CreateDatabaseTokenRes, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	db, err := h.database(params.OrganizationSlug, params.DatabaseName)
+	if err != nil {
+		return &client.DatabaseNotFoundResponse{Error: client.NewOptString(err.Error())}, nil
+	}
+
+	jwt, err := newJWT(db.database.DbId.Value, string(params.Authorization.Or(client.CreateDatabaseTokenAuthorizationFullAccess)), params.Expiration.Value)
+	if err != nil {
+		return &client.CreateDatabaseTokenBadRequest{Error: client.NewOptString(err.Error())}, nil
+	}
+
+	return &client.CreateDatabaseTokenOK{Jwt: client.NewOptString(jwt)}, nil
+}
+
+func (h *Handler) InvalidateDatabaseTokens(ctx context.Context, params client.InvalidateDatabaseTokensParams) (client.InvalidateDatabaseTokensRes, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, err := h.database(params.OrganizationSlug, params.DatabaseName); err != nil {
+		return &client.DatabaseNotFoundResponse{Error: client.NewOptString(err.Error())}, nil
+	}
+
+	return &client.InvalidateDatabaseTokensOK{}, nil
+}
+
+// MoveDatabase moves a database to another group, as if it was done outside
+// of Terraform.
+func (h *Handler) MoveDatabase(organizationSlug, name, group string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(organizationSlug)
+	if err != nil {
+		return err
+	}
+
+	db, err := h.database(organizationSlug, name)
+	if err != nil {
+		return err
+	}
+
+	moved, err := h.newDatabase(org, name, group)
+	if err != nil {
+		return err
+	}
+
+	db.database.Group = moved.database.Group
+	db.database.Regions = moved.database.Regions
+	db.database.PrimaryRegion = moved.database.PrimaryRegion
+	db.instances = moved.instances
+	db.usage = moved.usage
+
+	return nil
+}
+
+// database must be called with h.mu held.
+func (h *Handler) database(organizationSlug, name string) (*database, error) {
+	org, err := h.organization(organizationSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	db, ok := org.databases[name]
+	if !ok {
+		return nil, notFound("database %s not found", name)
+	}
+
+	return db, nil
+}
+
+// newDatabase builds a database with an instance in every location of its
+// group. It must be called with h.mu held.
+func (h *Handler) newDatabase(org *organization, name, groupName string) (*database, error) {
+	group, ok := org.groups[groupName]
+	if !ok {
+		return nil, fmt.Errorf("group %s does not exist", groupName)
+	}
+
+	slug := org.organization.Slug.Value
+	location := group.Primary.Value
+
+	db := &database{
+		database: client.Database{
+			Name:          client.NewOptString(name),
+			DbId:          client.NewOptString(h.uuid()),
+			Hostname:      client.NewOptString(fmt.Sprintf("%s-%s.%s.turso.io", name, slug, location)),
+			Regions:       append([]string{}, group.Locations...),
+			PrimaryRegion: client.NewOptString(location),
+			Group:         client.NewOptString(groupName),
+			IsSchema:      client.NewOptBool(false),
+		},
+		configuration: client.DatabaseConfigurationResponse{
+			SizeLimit:        client.NewOptString(""),
+			AllowAttach:      client.NewOptBool(false),
+			BlockReads:       client.NewOptBool(false),
+			BlockWrites:      client.NewOptBool(false),
+			DeleteProtection: client.NewOptBool(false),
+		},
+	}
+
+	for _, location := range group.Locations {
+		instanceType := client.InstanceTypeReplica
+		if location == group.Primary.Value {
+			instanceType = client.InstanceTypePrimary
+		}
+
+		db.instances = append(db.instances, client.Instance{
+			UUID:     client.NewOptString(h.uuid()),
+			Name:     client.NewOptString(location),
+			Type:     client.NewOptInstanceType(instanceType),
+			Region:   client.NewOptString(location),
+			Hostname: client.NewOptString(fmt.Sprintf("%s-%s-%s.turso.io", location, name, slug)),
+		})
+		db.usage = append(db.usage, client.DatabaseUsageObject{
+			RowsRead:     client.NewOptInt(0),
+			RowsWritten:  client.NewOptInt(0),
+			StorageBytes: client.NewOptInt(0),
+			BytesSynced:  client.NewOptInt(0),
+		})
+	}
+
+	db.syncConfiguration()
+
+	return db, nil
+}
+
+// syncConfiguration mirrors the configuration fields that GetDatabase also
+// returns.
+func (db *database) syncConfiguration() {
+	db.database.BlockReads = client.NewOptBool(db.configuration.BlockReads.Value)
+	db.database.BlockWrites = client.NewOptBool(db.configuration.BlockWrites.Value)
+	db.database.DeleteProtection = client.NewOptBool(db.configuration.DeleteProtection.Value)
+}
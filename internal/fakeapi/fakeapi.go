@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakeapi provides an in-memory implementation of the Turso Platform
+// API, built on the server side of the generated client. It lets acceptance
+// tests run offline.
+package fakeapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"terraform-provider-turso/internal/client"
+
+	"github.com/go-faster/jx"
+	"github.com/ogen-go/ogen/ogenerrors"
+)
+
+// Ensure Handler satisfies the generated server interface.
+var _ client.Handler = &Handler{}
+
+// Error is returned by handler methods for responses that the API
+// specification does not model, such as a 404 for GetDatabaseConfiguration.
+// Header is added to the response, e.g. Retry-After for a 429.
+type Error struct {
+	StatusCode int
+	Message    string
+	Header     http.Header
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func notFound(format string, args ...interface{}) error {
+	return &Error{StatusCode: http.StatusNotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return &Error{StatusCode: http.StatusBadRequest, Message: fmt.Sprintf(format, args...)}
+}
+
+// Handler is an in-memory Turso Platform API. Operations that are not
+// implemented respond with 501 Not Implemented.
+type Handler struct {
+	client.UnimplementedHandler
+
+	mu            sync.Mutex
+	organizations map[string]*organization
+	users         map[string]string
+	apiTokens     map[string]*client.APIToken
+	sequence      int
+}
+
+type organization struct {
+	organization client.Organization
+	groups       map[string]*client.Group
+	databases    map[string]*database
+	members      map[string]*client.Member
+	invites      map[string]*client.Invite
+	invoices     []invoice
+	auditLogs    []client.AuditLog
+}
+
+type invoice struct {
+	invoice  client.ListOrganizationInvoicesOKInvoicesItem
+	upcoming bool
+}
+
+type database struct {
+	database      client.Database
+	configuration client.DatabaseConfigurationResponse
+	instances     []client.Instance
+	// usage holds the usage of every instance, in the same order as instances.
+	usage      []client.DatabaseUsageObject
+	topQueries []client.DatabaseStatsOutput
+}
+
+func NewHandler() *Handler {
+	return &Handler{
+		organizations: map[string]*organization{},
+		users:         map[string]string{},
+		apiTokens:     map[string]*client.APIToken{},
+	}
+}
+
+// NewServer starts an HTTP server serving h. Requests must be authenticated
+// with token. Additional options, such as middlewares, are passed to the
+// generated server.
+func NewServer(h client.Handler, token string, opts ...client.ServerOption) (*httptest.Server, error) {
+	opts = append([]client.ServerOption{client.WithErrorHandler(errorHandler)}, opts...)
+
+	server, err := client.NewServer(h, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			writeError(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+		server.ServeHTTP(w, r)
+	})), nil
+}
+
+func errorHandler(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		for name, values := range apiErr.Header {
+			w.Header()[name] = values
+		}
+		writeError(w, apiErr.StatusCode, apiErr.Message)
+		return
+	}
+
+	ogenerrors.DefaultErrorHandler(ctx, w, r, err)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+
+	e.ObjStart()
+	e.FieldStart("error")
+	e.Str(message)
+	e.ObjEnd()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(e.Bytes())
+}
+
+// AddOrganization seeds an organization.
+func (h *Handler) AddOrganization(slug, name string, orgType client.OrganizationType) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.organizations[slug] = &organization{
+		organization: client.Organization{
+			Name:          client.NewOptString(name),
+			Slug:          client.NewOptString(slug),
+			Type:          client.NewOptOrganizationType(orgType),
+			Overages:      client.NewOptBool(false),
+			BlockedReads:  client.NewOptBool(false),
+			BlockedWrites: client.NewOptBool(false),
+			PlanID:        client.NewOptString("starter"),
+			PlanTimeline:  client.NewOptString("monthly"),
+			Platform:      client.NewOptString(""),
+		},
+		groups:    map[string]*client.Group{},
+		databases: map[string]*database{},
+		members:   map[string]*client.Member{},
+		invites:   map[string]*client.Invite{},
+	}
+}
+
+// AddUser seeds a Turso user that can be added to organizations.
+func (h *Handler) AddUser(username, email string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.users[username] = email
+}
+
+// AddMember seeds a member of an existing organization. The user is created
+// if it does not exist yet.
+func (h *Handler) AddMember(organizationSlug, username string, role client.MemberRole) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(organizationSlug)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := h.users[username]; !ok {
+		h.users[username] = username + "@example.com"
+	}
+
+	org.members[username] = h.newMember(username, role)
+
+	return nil
+}
+
+// AddGroup seeds a group in an existing organization.
+func (h *Handler) AddGroup(organizationSlug, name, location string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(organizationSlug)
+	if err != nil {
+		return err
+	}
+
+	org.groups[name] = h.newGroup(name, location)
+
+	return nil
+}
+
+// AddDatabase seeds a database in an existing group.
+func (h *Handler) AddDatabase(organizationSlug, name, group string, configuration client.DatabaseConfigurationResponse) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(organizationSlug)
+	if err != nil {
+		return err
+	}
+
+	db, err := h.newDatabase(org, name, group)
+	if err != nil {
+		return err
+	}
+
+	db.configuration = configuration
+	db.syncConfiguration()
+	org.databases[name] = db
+
+	return nil
+}
+
+// organization must be called with h.mu held.
+func (h *Handler) organization(slug string) (*organization, error) {
+	org, ok := h.organizations[slug]
+	if !ok {
+		return nil, notFound("organization %s not found", slug)
+	}
+
+	return org, nil
+}
+
+// uuid returns a new deterministic identifier. It must be called with h.mu
+// held.
+func (h *Handler) uuid() string {
+	h.sequence++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", h.sequence)
+}
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-turso/internal/client"
+	"testing"
+	"time"
+)
+
+type bearerTransport string
+
+func (t bearerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.Header.Set("Authorization", "Bearer "+string(t))
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func newTestClient(t *testing.T, token string, opts ...client.ServerOption) (*Handler, *client.Client) {
+	t.Helper()
+
+	handler := NewHandler()
+	handler.AddOrganization("acme", "Acme", client.OrganizationTypeTeam)
+	if err := handler.AddGroup("acme", "default", "aws-eu-west-1"); err != nil {
+		t.Fatalf("unable to seed group: %s", err)
+	}
+
+	server, err := NewServer(handler, "secret", opts...)
+	if err != nil {
+		t.Fatalf("unable to start server: %s", err)
+	}
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(server.URL, client.WithClient(&http.Client{Transport: bearerTransport(token)}))
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	return handler, c
+}
+
+func TestServerRequiresToken(t *testing.T) {
+	_, c := newTestClient(t, "wrong")
+
+	if _, err := c.GetOrganization(context.Background(), client.GetOrganizationParams{OrganizationSlug: "acme"}); err == nil {
+		t.Fatal("expected an error for an invalid token")
+	}
+}
+
+func TestDatabaseLifecycle(t *testing.T) {
+	ctx := context.Background()
+	_, c := newTestClient(t, "secret")
+
+	res, err := c.CreateDatabase(ctx, &client.CreateDatabaseInput{Name: "db", Group: "default"}, client.CreateDatabaseParams{OrganizationSlug: "acme"})
+	if err != nil {
+		t.Fatalf("unable to create database: %s", err)
+	}
+
+	created, ok := res.(*client.CreateDatabaseOK)
+	if !ok {
+		t.Fatalf("expected CreateDatabaseOK, got %T", res)
+	}
+	if hostname := created.Database.Value.Hostname.Value; hostname != "db-acme.aws-eu-west-1.turso.io" {
+		t.Errorf("unexpected hostname %s", hostname)
+	}
+
+	res, err = c.CreateDatabase(ctx, &client.CreateDatabaseInput{Name: "db", Group: "default"}, client.CreateDatabaseParams{OrganizationSlug: "acme"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, ok := res.(*client.CreateDatabaseConflict); !ok {
+		t.Errorf("expected CreateDatabaseConflict, got %T", res)
+	}
+
+	instances, err := c.ListDatabaseInstances(ctx, client.ListDatabaseInstancesParams{OrganizationSlug: "acme", DatabaseName: "db"})
+	if err != nil {
+		t.Fatalf("unable to list instances: %s", err)
+	}
+	if len(instances.Instances) != 1 || instances.Instances[0].Type.Value != client.InstanceTypePrimary {
+		t.Errorf("expected a single primary instance, got %v", instances.Instances)
+	}
+
+	if _, err := c.DeleteDatabase(ctx, client.DeleteDatabaseParams{OrganizationSlug: "acme", DatabaseName: "db"}); err != nil {
+		t.Fatalf("unable to delete database: %s", err)
+	}
+
+	deleted, err := c.GetDatabase(ctx, client.GetDatabaseParams{OrganizationSlug: "acme", DatabaseName: "db"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, ok := deleted.(*client.DatabaseNotFoundResponse); !ok {
+		t.Errorf("expected DatabaseNotFoundResponse, got %T", deleted)
+	}
+}
+
+func TestFaults(t *testing.T) {
+	ctx := context.Background()
+	faults := &Faults{}
+	_, c := newTestClient(t, "secret", client.WithMiddleware(faults.Middleware()))
+
+	faults.Inject(client.CreateDatabaseOperation, 2, Fault{
+		StatusCode: http.StatusTooManyRequests,
+		Message:    "slow down",
+		Header:     http.Header{"Retry-After": []string{"1"}},
+	})
+
+	input := &client.CreateDatabaseInput{Name: "db", Group: "default"}
+	params := client.CreateDatabaseParams{OrganizationSlug: "acme"}
+
+	for i := 0; i < 2; i++ {
+		_, err := c.CreateDatabase(ctx, input, params)
+		if err == nil || !strings.Contains(err.Error(), "429") {
+			t.Fatalf("call %d: expected a 429 error, got %v", i, err)
+		}
+	}
+
+	res, err := c.CreateDatabase(ctx, input, params)
+	if err != nil {
+		t.Fatalf("unable to create database: %s", err)
+	}
+	if _, ok := res.(*client.CreateDatabaseOK); !ok {
+		t.Errorf("expected CreateDatabaseOK, got %T", res)
+	}
+	if calls := faults.Calls(client.CreateDatabaseOperation); calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+
+	faults.Fail(client.GetDatabaseOperation, -1, http.StatusNotFound)
+	for i := 0; i < 3; i++ {
+		res, err := c.GetDatabase(ctx, client.GetDatabaseParams{OrganizationSlug: "acme", DatabaseName: "db"})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if _, ok := res.(*client.DatabaseNotFoundResponse); !ok {
+			t.Errorf("expected DatabaseNotFoundResponse, got %T", res)
+		}
+	}
+
+	faults.Reset()
+	faults.Fail(client.GetDatabaseOperation, 0, http.StatusNotFound)
+	getRes, err := c.GetDatabase(ctx, client.GetDatabaseParams{OrganizationSlug: "acme", DatabaseName: "db"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, ok := getRes.(*client.GetDatabaseOK); !ok {
+		t.Errorf("expected a fault injected zero times to be ignored, got %T", getRes)
+	}
+
+	faults.Reset()
+	faults.Delay(client.GetDatabaseOperation, 1, time.Second)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.GetDatabase(timeoutCtx, client.GetDatabaseParams{OrganizationSlug: "acme", DatabaseName: "db"}); err == nil {
+		t.Error("expected the delayed call to time out")
+	}
+}
+
+func TestParseExpiration(t *testing.T) {
+	cases := map[string]time.Duration{
+		"30m":    30 * time.Minute,
+		"1d12h":  36 * time.Hour,
+		"2w1d5s": 15*24*time.Hour + 5*time.Second,
+	}
+
+	for expiration, expected := range cases {
+		actual, err := parseExpiration(expiration)
+		if err != nil || actual != expected {
+			t.Errorf("%s: expected %s, got %s (%v)", expiration, expected, actual, err)
+		}
+	}
+
+	for _, expiration := range []string{"", "10", "m", "1x"} {
+		if _, err := parseExpiration(expiration); err == nil {
+			t.Errorf("expected %q to be rejected", expiration)
+		}
+	}
+}
+
+func ExampleNewServer() {
+	handler := NewHandler()
+	handler.AddOrganization("acme", "Acme", client.OrganizationTypeTeam)
+
+	server, _ := NewServer(handler, "secret")
+	defer server.Close()
+
+	c, _ := client.NewClient(server.URL, client.WithClient(&http.Client{Transport: bearerTransport("secret")}))
+	organizations, _ := c.ListOrganizations(context.Background())
+
+	fmt.Println(organizations[0].Slug.Value)
+	// Output: acme
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeapi
+
+import (
+	"net/http"
+	"sync"
+	"terraform-provider-turso/internal/client"
+	"time"
+
+	"github.com/ogen-go/ogen/middleware"
+)
+
+// Fault describes how an operation misbehaves. Delay is applied first; if
+// StatusCode is zero the request then proceeds normally, which simulates a
+// slow response.
+type Fault struct {
+	StatusCode int
+	Message    string
+	Delay      time.Duration
+	Header     http.Header
+}
+
+type injectedFault struct {
+	operation client.OperationName
+	remaining int
+	fault     Fault
+}
+
+// Faults is a scriptable fault-injection layer for the fake API. Install it on
+// a server with NewServer(h, token, client.WithMiddleware(faults.Middleware())).
+// The zero value is ready to use.
+type Faults struct {
+	mu     sync.Mutex
+	faults []*injectedFault
+	calls  map[client.OperationName]int
+}
+
+// Inject makes the next times calls to operation, such as
+// client.CreateDatabaseOperation, misbehave as described by fault. A negative
+// times injects the fault into every call until Reset, and zero injects
+// nothing.
+func (f *Faults) Inject(operation client.OperationName, times int, fault Fault) {
+	if times == 0 {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.faults = append(f.faults, &injectedFault{operation: operation, remaining: times, fault: fault})
+}
+
+// Fail makes the next times calls to operation fail with statusCode.
+func (f *Faults) Fail(operation client.OperationName, times int, statusCode int) {
+	f.Inject(operation, times, Fault{StatusCode: statusCode, Message: http.StatusText(statusCode)})
+}
+
+// Delay makes the next times calls to operation respond after d.
+func (f *Faults) Delay(operation client.OperationName, times int, d time.Duration) {
+	f.Inject(operation, times, Fault{Delay: d})
+}
+
+// Reset removes all pending faults and call counts.
+func (f *Faults) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.faults = nil
+	f.calls = nil
+}
+
+// Calls returns how many times operation was called, including calls that
+// failed because of an injected fault.
+func (f *Faults) Calls(operation client.OperationName) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.calls[operation]
+}
+
+// Middleware returns the middleware applying the injected faults.
+func (f *Faults) Middleware() client.Middleware {
+	return func(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+		fault, ok := f.next(req.OperationName)
+		if !ok {
+			return next(req)
+		}
+
+		if fault.Delay > 0 {
+			timer := time.NewTimer(fault.Delay)
+			defer timer.Stop()
+
+			select {
+			case <-timer.C:
+			case <-req.Context.Done():
+				return middleware.Response{}, req.Context.Err()
+			}
+		}
+
+		if fault.StatusCode == 0 {
+			return next(req)
+		}
+
+		return middleware.Response{}, &Error{StatusCode: fault.StatusCode, Message: fault.Message, Header: fault.Header}
+	}
+}
+
+// next records a call to operation and returns the fault to apply, if any.
+func (f *Faults) next(operation client.OperationName) (Fault, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.calls == nil {
+		f.calls = map[client.OperationName]int{}
+	}
+	f.calls[operation]++
+
+	for i, injected := range f.faults {
+		if injected.operation != operation {
+			continue
+		}
+
+		// A negative count never runs out.
+		if injected.remaining < 0 {
+			return injected.fault, true
+		}
+
+		injected.remaining--
+		if injected.remaining == 0 {
+			f.faults = append(f.faults[:i], f.faults[i+1:]...)
+		}
+
+		return injected.fault, true
+	}
+
+	return Fault{}, false
+}
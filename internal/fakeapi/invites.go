@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"terraform-provider-turso/internal/client"
+	"time"
+)
+
+func (h *Handler) ListOrganizationInvites(ctx context.Context, params client.ListOrganizationInvitesParams) (*client.ListOrganizationInvitesOK, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(params.OrganizationSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	invites := []client.Invite{}
+	for _, invite := range org.invites {
+		invites = append(invites, *invite)
+	}
+
+	sort.Slice(invites, func(i, j int) bool {
+		return invites[i].Email.Value < invites[j].Email.Value
+	})
+
+	return &client.ListOrganizationInvitesOK{Invites: invites}, nil
+}
+
+func (h *Handler) InviteOrganizationMember(ctx context.Context, req *client.InviteOrganizationMemberReq, params client.InviteOrganizationMemberParams) (*client.InviteOrganizationMemberOK, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(params.OrganizationSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	if invite, ok := org.invites[req.Email]; ok && !invite.Accepted.Value {
+		return nil, &Error{StatusCode: http.StatusConflict, Message: fmt.Sprintf("%s has already been invited", req.Email)}
+	}
+
+	h.sequence++
+	now := time.Now().UTC().Format(time.RFC3339)
+	invite := &client.Invite{
+		ID:           client.NewOptInt(h.sequence),
+		CreatedAt:    client.NewOptString(now),
+		UpdatedAt:    client.NewOptString(now),
+		Role:         client.NewOptInviteRole(client.InviteRole(req.Role.Or(client.InviteOrganizationMemberReqRoleMember))),
+		Email:        client.NewOptString(req.Email),
+		Token:        client.NewOptString(h.uuid()),
+		Organization: client.NewOptOrganization(org.organization),
+		Accepted:     client.NewOptBool(false),
+	}
+	org.invites[req.Email] = invite
+
+	return &client.InviteOrganizationMemberOK{Invited: client.NewOptInvite(*invite)}, nil
+}
+
+func (h *Handler) DeleteOrganizationInviteByEmail(ctx context.Context, params client.DeleteOrganizationInviteByEmailParams) (client.DeleteOrganizationInviteByEmailRes, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(params.OrganizationSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	invite, ok := org.invites[params.Email]
+	if !ok || invite.Accepted.Value {
+		return &client.DeleteOrganizationInviteByEmailNotFound{
+			Code:  client.NewOptString("invite_not_found"),
+			Error: client.NewOptString(fmt.Sprintf("no pending invite for %s", params.Email)),
+		}, nil
+	}
+
+	delete(org.invites, params.Email)
+
+	return &client.DeleteOrganizationInviteByEmailOK{}, nil
+}
+
+// AcceptInvite accepts the pending invite sent to email, making username a
+// member of the organization with the invited role.
+func (h *Handler) AcceptInvite(organizationSlug, email, username string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(organizationSlug)
+	if err != nil {
+		return err
+	}
+
+	invite, ok := org.invites[email]
+	if !ok || invite.Accepted.Value {
+		return notFound("no pending invite for %s", email)
+	}
+
+	invite.Accepted = client.NewOptBool(true)
+	h.users[username] = email
+	org.members[username] = h.newMember(username, client.MemberRole(invite.Role.Value))
+
+	return nil
+}
+
+// RemoveInvite removes the invite sent to email, whether or not it was
+// accepted, as the API does when it cleans up accepted invites.
+func (h *Handler) RemoveInvite(organizationSlug, email string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(organizationSlug)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := org.invites[email]; !ok {
+		return notFound("no invite for %s", email)
+	}
+
+	delete(org.invites, email)
+
+	return nil
+}
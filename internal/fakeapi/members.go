@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"terraform-provider-turso/internal/client"
+)
+
+func (h *Handler) ListOrganizationMembers(ctx context.Context, params client.ListOrganizationMembersParams) (*client.ListOrganizationMembersOK, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(params.OrganizationSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	members := []client.Member{}
+	for _, member := range org.members {
+		members = append(members, *member)
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Username.Value < members[j].Username.Value
+	})
+
+	return &client.ListOrganizationMembersOK{Members: members}, nil
+}
+
+func (h *Handler) GetOrganizationMember(ctx context.Context, params client.GetOrganizationMemberParams) (client.GetOrganizationMemberRes, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	member, err := h.member(params.OrganizationSlug, params.Username)
+	if err != nil {
+		return &client.GetOrganizationMemberNotFound{Error: client.NewOptString(err.Error())}, nil
+	}
+
+	return &client.GetOrganizationMemberOK{Member: client.NewOptMember(*member)}, nil
+}
+
+func (h *Handler) AddOrganizationMember(ctx context.Context, req *client.AddOrganizationMemberReq, params client.AddOrganizationMemberParams) (client.AddOrganizationMemberRes, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(params.OrganizationSlug)
+	if err != nil {
+		return &client.AddOrganizationMemberNotFound{Error: client.NewOptString(err.Error())}, nil
+	}
+
+	username := req.Username.Value
+	if _, ok := h.users[username]; !ok {
+		return &client.AddOrganizationMemberNotFound{Error: client.NewOptString(fmt.Sprintf("user %s not found", username))}, nil
+	}
+
+	if _, ok := org.members[username]; ok {
+		return &client.AddOrganizationMemberConflict{Error: client.NewOptString(fmt.Sprintf("user %s is already a member", username))}, nil
+	}
+
+	role := client.MemberRole(req.Role.Or(client.AddOrganizationMemberReqRoleMember))
+	org.members[username] = h.newMember(username, role)
+
+	return &client.AddOrganizationMemberOK{
+		Member: client.NewOptUsername(client.Username(username)),
+		Role:   client.NewOptRole(client.Role(role)),
+	}, nil
+}
+
+func (h *Handler) UpdateMemberRole(ctx context.Context, req *client.UpdateMemberRoleReq, params client.UpdateMemberRoleParams) (client.UpdateMemberRoleRes, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	member, err := h.member(params.OrganizationSlug, params.Username)
+	if err != nil {
+		return &client.UpdateMemberRoleNotFound{Error: client.NewOptString(err.Error())}, nil
+	}
+
+	if member.Role.Value == client.MemberRoleOwner {
+		return &client.UpdateMemberRoleForbidden{Error: client.NewOptString("the role of the organization owner cannot be changed")}, nil
+	}
+
+	member.Role = client.NewOptMemberRole(client.MemberRole(req.Role))
+
+	return &client.UpdateMemberRoleOK{Member: client.NewOptUpdateMemberRoleOKMember(client.UpdateMemberRoleOKMember{
+		Username: member.Username,
+		Email:    member.Email,
+		Role:     client.NewOptUpdateMemberRoleOKMemberRole(client.UpdateMemberRoleOKMemberRole(req.Role)),
+	})}, nil
+}
+
+func (h *Handler) RemoveOrganizationMember(ctx context.Context, params client.RemoveOrganizationMemberParams) (client.RemoveOrganizationMemberRes, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	member, err := h.member(params.OrganizationSlug, params.Username)
+	if err != nil {
+		return &client.RemoveOrganizationMemberNotFound{Error: client.NewOptString(err.Error())}, nil
+	}
+
+	if member.Role.Value == client.MemberRoleOwner {
+		return nil, badRequest("the organization owner cannot be removed")
+	}
+
+	delete(h.organizations[params.OrganizationSlug].members, params.Username)
+
+	return &client.RemoveOrganizationMemberOK{Member: client.NewOptUsername(client.Username(params.Username))}, nil
+}
+
+// member must be called with h.mu held.
+func (h *Handler) member(organizationSlug, username string) (*client.Member, error) {
+	org, err := h.organization(organizationSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	member, ok := org.members[username]
+	if !ok {
+		return nil, notFound("member %s not found", username)
+	}
+
+	return member, nil
+}
+
+// newMember must be called with h.mu held.
+func (h *Handler) newMember(username string, role client.MemberRole) *client.Member {
+	return &client.Member{
+		Username: client.NewOptString(username),
+		Role:     client.NewOptMemberRole(role),
+		Email:    client.NewOptString(h.users[username]),
+	}
+}
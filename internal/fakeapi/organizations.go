@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeapi
+
+import (
+	"context"
+	"sort"
+	"terraform-provider-turso/internal/client"
+)
+
+func (h *Handler) ListOrganizations(ctx context.Context) ([]client.Organization, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	organizations := []client.Organization{}
+	for _, org := range h.organizations {
+		organizations = append(organizations, org.organization)
+	}
+
+	sort.Slice(organizations, func(i, j int) bool {
+		return organizations[i].Slug.Value < organizations[j].Slug.Value
+	})
+
+	return organizations, nil
+}
+
+func (h *Handler) GetOrganization(ctx context.Context, params client.GetOrganizationParams) (client.GetOrganizationRes, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, ok := h.organizations[params.OrganizationSlug]
+	if !ok {
+		return &client.GetOrganizationNotFound{Error: client.NewOptString("organization not found")}, nil
+	}
+
+	return &client.GetOrganizationOK{Organization: client.NewOptOrganization(org.organization)}, nil
+}
+
+func (h *Handler) UpdateOrganization(ctx context.Context, req *client.UpdateOrganizationReq, params client.UpdateOrganizationParams) (*client.UpdateOrganizationOK, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(params.OrganizationSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Overages.Set {
+		org.organization.Overages = req.Overages
+	}
+
+	return &client.UpdateOrganizationOK{Organization: client.NewOptOrganization(org.organization)}, nil
+}
+
+func (h *Handler) ListGroups(ctx context.Context, params client.ListGroupsParams) (*client.ListGroupsOK, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(params.OrganizationSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := []client.Group{}
+	for _, group := range org.groups {
+		groups = append(groups, *group)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Name.Value < groups[j].Name.Value
+	})
+
+	return &client.ListGroupsOK{Groups: groups}, nil
+}
+
+func (h *Handler) GetGroup(ctx context.Context, params client.GetGroupParams) (client.GetGroupRes, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	group, err := h.group(params.OrganizationSlug, params.GroupName)
+	if err != nil {
+		return &client.GroupNotFoundResponse{Error: client.NewOptString(err.Error())}, nil
+	}
+
+	return &client.GetGroupOK{Group: client.NewOptGroup(*group)}, nil
+}
+
+func (h *Handler) CreateGroup(ctx context.Context, req *client.NewGroup, params client.CreateGroupParams) (client.CreateGroupRes, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(params.OrganizationSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := org.groups[req.Name]; ok {
+		return &client.CreateGroupConflict{Error: client.NewOptString("group already exists")}, nil
+	}
+
+	group := h.newGroup(req.Name, req.Location)
+	org.groups[req.Name] = group
+
+	return &client.CreateGroupOK{Group: client.NewOptGroup(*group)}, nil
+}
+
+func (h *Handler) DeleteGroup(ctx context.Context, params client.DeleteGroupParams) (client.DeleteGroupRes, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	group, err := h.group(params.OrganizationSlug, params.GroupName)
+	if err != nil {
+		return &client.GroupNotFoundResponse{Error: client.NewOptString(err.Error())}, nil
+	}
+
+	org := h.organizations[params.OrganizationSlug]
+	for name, db := range org.databases {
+		if db.database.Group.Value == params.GroupName {
+			delete(org.databases, name)
+		}
+	}
+	delete(org.groups, params.GroupName)
+
+	return &client.DeleteGroupOK{Group: client.NewOptGroup(*group)}, nil
+}
+
+func (h *Handler) CreateGroupToken(ctx context.Context, req client.OptCreateTokenInput, params client.CreateGroupTokenParams) (client.CreateGroupTokenRes, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	group, err := h.group(params.OrganizationSlug, params.GroupName)
+	if err != nil {
+		return &client.GroupNotFoundResponse{Error: client.NewOptString(err.Error())}, nil
+	}
+
+	jwt, err := newJWT(group.UUID.Value, string(params.Authorization.Or(client.CreateGroupTokenAuthorizationFullAccess)), params.Expiration.Value)
+	if err != nil {
+		return &client.CreateGroupTokenBadRequest{Error: client.NewOptString(err.Error())}, nil
+	}
+
+	return &client.CreateGroupTokenOK{Jwt: client.NewOptString(jwt)}, nil
+}
+
+func (h *Handler) InvalidateGroupTokens(ctx context.Context, params client.InvalidateGroupTokensParams) (client.InvalidateGroupTokensRes, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, err := h.group(params.OrganizationSlug, params.GroupName); err != nil {
+		return &client.GroupNotFoundResponse{Error: client.NewOptString(err.Error())}, nil
+	}
+
+	return &client.InvalidateGroupTokensOK{}, nil
+}
+
+// group must be called with h.mu held.
+func (h *Handler) group(organizationSlug, name string) (*client.Group, error) {
+	org, err := h.organization(organizationSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	group, ok := org.groups[name]
+	if !ok {
+		return nil, notFound("group %s not found", name)
+	}
+
+	return group, nil
+}
+
+// newGroup must be called with h.mu held.
+func (h *Handler) newGroup(name, location string) *client.Group {
+	return &client.Group{
+		Name:             client.NewOptString(name),
+		Version:          client.NewOptString("v0.24.0"),
+		UUID:             client.NewOptString(h.uuid()),
+		Locations:        []string{location},
+		Primary:          client.NewOptString(location),
+		DeleteProtection: client.NewOptBool(false),
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeapi
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// newJWT returns a token shaped like the ones minted by Turso. The signature
+// is random, so it is only good for tests.
+func newJWT(id, authorization, expiration string) (string, error) {
+	access := "rw"
+	if authorization == "read-only" {
+		access = "ro"
+	}
+
+	now := time.Now()
+	claims := map[string]interface{}{
+		"a":   access,
+		"iat": now.Unix(),
+		"id":  id,
+	}
+
+	if expiration != "" && expiration != "never" {
+		ttl, err := parseExpiration(expiration)
+		if err != nil {
+			return "", err
+		}
+		claims["exp"] = now.Add(ttl).Unix()
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": "EdDSA", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signature := make([]byte, 64)
+	if _, err := rand.Read(signature); err != nil {
+		return "", err
+	}
+
+	encoding := base64.RawURLEncoding
+	return encoding.EncodeToString(header) + "." + encoding.EncodeToString(payload) + "." + encoding.EncodeToString(signature), nil
+}
+
+// parseExpiration parses expirations such as 2w1d30m.
+func parseExpiration(expiration string) (time.Duration, error) {
+	units := map[byte]time.Duration{
+		'w': 7 * 24 * time.Hour,
+		'd': 24 * time.Hour,
+		'h': time.Hour,
+		'm': time.Minute,
+		's': time.Second,
+	}
+
+	var total time.Duration
+	start := 0
+
+	for i := 0; i < len(expiration); i++ {
+		unit, ok := units[expiration[i]]
+		if !ok {
+			continue
+		}
+
+		value, err := strconv.Atoi(expiration[start:i])
+		if err != nil {
+			return 0, fmt.Errorf("invalid expiration %q", expiration)
+		}
+
+		total += time.Duration(value) * unit
+		start = i + 1
+	}
+
+	if start != len(expiration) || total <= 0 {
+		return 0, fmt.Errorf("invalid expiration %q", expiration)
+	}
+
+	return total, nil
+}
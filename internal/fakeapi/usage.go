@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeapi
+
+import (
+	"context"
+	"terraform-provider-turso/internal/client"
+)
+
+// plans mirrors the plans offered by Turso. A database quota of -1 means
+// unlimited.
+var plans = []struct {
+	name        string
+	price       string
+	rowsRead    int
+	rowsWritten int
+	databases   int
+	locations   int
+	storage     int
+	groups      int
+	bytesSynced int
+}{
+	{"starter", "0", 1_000_000_000, 25_000_000, 500, 3, 9_000_000_000, 1, 3_000_000_000},
+	{"scaler", "29", 100_000_000_000, 100_000_000, 10_000, 6, 24_000_000_000, 3, 24_000_000_000},
+	{"pro", "416.58", 250_000_000_000, 250_000_000, -1, 10, 50_000_000_000, 20, 100_000_000_000},
+}
+
+func (h *Handler) ListOrganizationPlans(ctx context.Context, params client.ListOrganizationPlansParams) (*client.ListOrganizationPlansOK, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, err := h.organization(params.OrganizationSlug); err != nil {
+		return nil, err
+	}
+
+	res := &client.ListOrganizationPlansOK{Plans: []client.OrganizationPlan{}}
+	for _, plan := range plans {
+		databases := client.NewOptNilInt(plan.databases)
+		if plan.databases < 0 {
+			databases = client.OptNilInt{Set: true, Null: true}
+		}
+
+		res.Plans = append(res.Plans, client.OrganizationPlan{
+			Name:  client.NewOptString(plan.name),
+			Price: client.NewOptString(plan.price),
+			Prices: []client.PlanPrice{
+				{Value: client.NewOptString(plan.price), Timeline: client.NewOptString("monthly")},
+			},
+			Quotas: client.NewOptPlanQuotas(client.PlanQuotas{
+				RowsRead:    client.NewOptInt(plan.rowsRead),
+				RowsWritten: client.NewOptInt(plan.rowsWritten),
+				Databases:   databases,
+				Locations:   client.NewOptInt(plan.locations),
+				Storage:     client.NewOptInt(plan.storage),
+				Groups:      client.NewOptInt(plan.groups),
+				BytesSynced: client.NewOptInt(plan.bytesSynced),
+			}),
+		})
+	}
+
+	return res, nil
+}
+
+func (h *Handler) GetOrganizationUsage(ctx context.Context, params client.GetOrganizationUsageParams) (*client.GetOrganizationUsageOK, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	org, err := h.organization(params.OrganizationSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := map[string]bool{}
+	for _, group := range org.groups {
+		for _, location := range group.Locations {
+			locations[location] = true
+		}
+	}
+
+	var total client.DatabaseUsageObject
+	databases := []client.DatabaseUsageOutput{}
+	for _, db := range org.databases {
+		output := db.usageOutput()
+		total = addUsage(total, output.Total.Value)
+		databases = append(databases, output)
+	}
+
+	return &client.GetOrganizationUsageOK{Organization: client.NewOptGetOrganizationUsageOKOrganization(client.GetOrganizationUsageOKOrganization{
+		UUID: client.NewOptString(params.OrganizationSlug),
+		Usage: client.NewOptGetOrganizationUsageOKOrganizationUsage(client.GetOrganizationUsageOKOrganizationUsage{
+			RowsRead:     total.RowsRead,
+			RowsWritten:  total.RowsWritten,
+			Databases:    client.NewOptInt(len(org.databases)),
+			Locations:    client.NewOptInt(len(locations)),
+			StorageBytes: total.StorageBytes,
+			Groups:       client.NewOptInt(len(org.groups)),
+			BytesSynced:  total.BytesSynced,
+		}),
+		Databases: databases,
+	})}, nil
+}
+
+func (h *Handler) GetDatabaseUsage(ctx context.Context, params client.GetDatabaseUsageParams) (client.GetDatabaseUsageRes, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	db, err := h.database(params.OrganizationSlug, params.DatabaseName)
+	if err != nil {
+		return &client.DatabaseNotFoundResponse{Error: client.NewOptString(err.Error())}, nil
+	}
+
+	// Usage is not tracked over time, so every window reports all of it.
+	if params.From.Set && params.To.Set && params.To.Value.Before(params.From.Value) {
+		return &client.GetDatabaseUsageBadRequest{Error: client.NewOptString("from must be before to")}, nil
+	}
+
+	return &client.GetDatabaseUsageOK{Database: client.NewOptDatabaseUsageOutput(db.usageOutput())}, nil
+}
+
+func (h *Handler) GetDatabaseStats(ctx context.Context, params client.GetDatabaseStatsParams) (client.GetDatabaseStatsRes, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	db, err := h.database(params.OrganizationSlug, params.DatabaseName)
+	if err != nil {
+		return &client.DatabaseNotFoundResponse{Error: client.NewOptString(err.Error())}, nil
+	}
+
+	topQueries := client.OptNilDatabaseStatsOutputArray{Set: true, Null: true}
+	if len(db.topQueries) > 0 {
+		topQueries = client.NewOptNilDatabaseStatsOutputArray(db.topQueries)
+	}
+
+	return &client.GetDatabaseStatsOK{TopQueries: topQueries}, nil
+}
+
+// SetDatabaseStats records the top queries of a database.
+func (h *Handler) SetDatabaseStats(organizationSlug, name string, topQueries []client.DatabaseStatsOutput) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	db, err := h.database(organizationSlug, name)
+	if err != nil {
+		return err
+	}
+
+	db.topQueries = topQueries
+
+	return nil
+}
+
+// SetDatabaseUsage records usage for the primary instance of a database.
+func (h *Handler) SetDatabaseUsage(organizationSlug, name string, usage client.DatabaseUsageObject) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	db, err := h.database(organizationSlug, name)
+	if err != nil {
+		return err
+	}
+
+	db.usage[0] = usage
+
+	return nil
+}
+
+// usageOutput returns the usage of every instance of db and their total.
+func (db *database) usageOutput() client.DatabaseUsageOutput {
+	output := client.DatabaseUsageOutput{
+		UUID:      client.NewOptDbId(client.DbId(db.database.DbId.Value)),
+		Instances: []client.DatabaseUsageOutputInstancesItem{},
+	}
+
+	var total client.DatabaseUsageObject
+	for i, instance := range db.instances {
+		output.Instances = append(output.Instances, client.DatabaseUsageOutputInstancesItem{
+			UUID:  instance.UUID,
+			Usage: client.NewOptDatabaseUsageObject(db.usage[i]),
+		})
+		total = addUsage(total, db.usage[i])
+	}
+	output.Total = client.NewOptDatabaseUsageObject(total)
+
+	return output
+}
+
+func addUsage(a, b client.DatabaseUsageObject) client.DatabaseUsageObject {
+	return client.DatabaseUsageObject{
+		RowsRead:     client.NewOptInt(a.RowsRead.Value + b.RowsRead.Value),
+		RowsWritten:  client.NewOptInt(a.RowsWritten.Value + b.RowsWritten.Value),
+		StorageBytes: client.NewOptInt(a.StorageBytes.Value + b.StorageBytes.Value),
+		BytesSynced:  client.NewOptInt(a.BytesSynced.Value + b.BytesSynced.Value),
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-turso/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testApiTokenResourceConfig = `
+resource "turso_api_token" "test" {
+	name = "tf-provider-token"
+}`
+
+func TestAccApiTokenResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testApiTokenResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("turso_api_token.test", "name", "tf-provider-token"),
+					resource.TestCheckResourceAttrSet("turso_api_token.test", "id"),
+					resource.TestCheckResourceAttrSet("turso_api_token.test", "token"),
+				),
+			},
+			{
+				ResourceName:                         "turso_api_token.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "tf-provider-token",
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"token"},
+			},
+		},
+	})
+}
+
+func TestApiTokenResourceRevokedOutsideTerraform(t *testing.T) {
+	config, handler, _ := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			res, err := handler.ListAPITokens(context.Background())
+			if err != nil {
+				return err
+			}
+			if len(res.Tokens) != 0 {
+				return fmt.Errorf("expected every API token to be revoked, got %v", res.Tokens)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config + testApiTokenResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					if _, err := handler.RevokeAPIToken(context.Background(), client.RevokeAPITokenParams{TokenName: "tf-provider-token"}); err != nil {
+						t.Fatalf("unable to revoke API token: %s", err)
+					}
+				},
+				Config:             config + testApiTokenResourceConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config + testApiTokenResourceConfig,
+				Check:  resource.TestCheckResourceAttrSet("turso_api_token.test", "token"),
+			},
+		},
+	})
+}
+
+func TestApiTokenResourceNameConflict(t *testing.T) {
+	config, _, _ := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + testApiTokenResourceConfig + `
+				resource "turso_api_token" "duplicate" {
+					name = "tf-provider-token"
+
+					depends_on = [turso_api_token.test]
+				}`,
+				ExpectError: regexp.MustCompile("Unable to create API token"),
+			},
+		},
+	})
+}
@@ -7,7 +7,10 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-turso/internal/client"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -19,6 +22,12 @@ import (
 var _ resource.Resource = &DatabaseConfigurationResource{}
 var _ resource.ResourceWithImportState = &DatabaseConfigurationResource{}
 
+const (
+	defaultDatabaseConfigurationCreateTimeout = 5 * time.Minute
+	defaultDatabaseConfigurationReadTimeout   = 5 * time.Minute
+	defaultDatabaseConfigurationUpdateTimeout = 5 * time.Minute
+)
+
 func NewDatabaseConfigurationResource() resource.Resource {
 	return &DatabaseConfigurationResource{}
 }
@@ -28,12 +37,13 @@ type DatabaseConfigurationResource struct {
 }
 
 type DatabaseConfigurationResourceModel struct {
-	OrganizationSlug types.String `tfsdk:"organization_slug"`
-	DatabaseName     types.String `tfsdk:"database_name"`
-	SizeLimit        types.String `tfsdk:"size_limit"`
-	BlockReads       types.Bool   `tfsdk:"block_reads"`
-	BlockWrites      types.Bool   `tfsdk:"block_writes"`
-	DeleteProtection types.Bool   `tfsdk:"delete_protection"`
+	OrganizationSlug types.String   `tfsdk:"organization_slug"`
+	DatabaseName     types.String   `tfsdk:"database_name"`
+	SizeLimit        types.String   `tfsdk:"size_limit"`
+	BlockReads       types.Bool     `tfsdk:"block_reads"`
+	BlockWrites      types.Bool     `tfsdk:"block_writes"`
+	DeleteProtection types.Bool     `tfsdk:"delete_protection"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *DatabaseConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -42,7 +52,8 @@ func (r *DatabaseConfigurationResource) Metadata(ctx context.Context, req resour
 
 func (r *DatabaseConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a database configuration belonging to the organization or user.",
+		MarkdownDescription: "Manages a database configuration belonging to the organization or user. " +
+			"It must not be used for a database whose settings are managed by its `turso_database` resource, as the two overwrite each other.",
 
 		Attributes: map[string]schema.Attribute{
 			"organization_slug": schema.StringAttribute{
@@ -76,6 +87,13 @@ func (r *DatabaseConfigurationResource) Schema(ctx context.Context, req resource
 				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+			}),
+		},
 	}
 }
 
@@ -106,6 +124,16 @@ func (r *DatabaseConfigurationResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultDatabaseConfigurationCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	res, err := r.client.UpdateDatabaseConfiguration(ctx, &client.DatabaseConfigurationInput{
 		SizeLimit:        client.NewOptString(data.SizeLimit.ValueString()),
 		BlockReads:       client.NewOptBool(data.BlockReads.ValueBool()),
@@ -138,16 +166,44 @@ func (r *DatabaseConfigurationResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultDatabaseConfigurationReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	res, err := r.client.GetDatabaseConfiguration(ctx, client.GetDatabaseConfigurationParams{
 		OrganizationSlug: data.OrganizationSlug.ValueString(),
 		DatabaseName:     data.DatabaseName.ValueString(),
 	})
 
+	if IsNotFoundError(err) {
+		// The database was deleted outside of Terraform, so its configuration is
+		// removed from the state as well.
+		tflog.Warn(ctx, fmt.Sprintf("Database %s not found, removing its configuration from state", data.DatabaseName.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database, got error: %s", err.Error()))
 		return
 	}
 
+	changed := changedDatabaseSettings([]databaseSetting{
+		{"size_limit", data.SizeLimit, types.StringValue(res.SizeLimit.Value)},
+		{"block_reads", data.BlockReads, types.BoolValue(res.BlockReads.Value)},
+		{"block_writes", data.BlockWrites, types.BoolValue(res.BlockWrites.Value)},
+		{"delete_protection", data.DeleteProtection, types.BoolValue(res.DeleteProtection.Value)},
+	})
+	if len(changed) > 0 {
+		resp.Diagnostics.Append(conflictingDatabaseSettingsWarning("turso_database_configuration", data.OrganizationSlug.ValueString(), data.DatabaseName.ValueString(), changed))
+	}
+
 	data.BlockReads = types.BoolValue(res.BlockReads.Value)
 	data.BlockWrites = types.BoolValue(res.BlockWrites.Value)
 	data.DeleteProtection = types.BoolValue(res.DeleteProtection.Value)
@@ -165,6 +221,16 @@ func (r *DatabaseConfigurationResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultDatabaseConfigurationUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	res, err := r.client.UpdateDatabaseConfiguration(ctx, &client.DatabaseConfigurationInput{
 		SizeLimit:        client.NewOptString(data.SizeLimit.ValueString()),
 		BlockReads:       client.NewOptBool(data.BlockReads.ValueBool()),
@@ -212,12 +278,10 @@ func (r *DatabaseConfigurationResource) ImportState(ctx context.Context, req res
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, DatabaseConfigurationResourceModel{
-		OrganizationSlug: types.StringValue(organization_name),
-		DatabaseName:     types.StringValue(name),
-		SizeLimit:        types.StringValue(res.SizeLimit.Value),
-		BlockReads:       types.BoolValue(res.BlockReads.Value),
-		BlockWrites:      types.BoolValue(res.BlockWrites.Value),
-		DeleteProtection: types.BoolValue(res.DeleteProtection.Value),
-	})...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_slug"), organization_name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database_name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("size_limit"), res.SizeLimit.Value)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("block_reads"), res.BlockReads.Value)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("block_writes"), res.BlockWrites.Value)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_protection"), res.DeleteProtection.Value)...)
 }
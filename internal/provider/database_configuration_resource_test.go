@@ -4,6 +4,9 @@
 package provider
 
 import (
+	"net/http"
+	"regexp"
+	"terraform-provider-turso/internal/client"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -42,3 +45,92 @@ func TestAccDatabaseConfigurationResource(t *testing.T) {
 		},
 	})
 }
+
+const testDatabaseConfigurationResourceConfig = `
+resource "turso_database_configuration" "test" {
+	organization_slug = "jpedroh"
+	database_name	  = "tfproviderdatasource"
+	size_limit	  	  = "1gb"
+	block_reads	      = true
+	block_writes	  = true
+	delete_protection = false
+}`
+
+func TestDatabaseConfigurationResourceUpdateError(t *testing.T) {
+	config, _, faults := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + testDatabaseConfigurationResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					faults.Fail(client.UpdateDatabaseConfigurationOperation, 1, http.StatusInternalServerError)
+				},
+				Config: config + `
+				resource "turso_database_configuration" "test" {
+					organization_slug = "jpedroh"
+					database_name	  = "tfproviderdatasource"
+					size_limit	  	  = "2gb"
+					block_reads	      = true
+					block_writes	  = true
+					delete_protection = false
+				}`,
+				ExpectError: regexp.MustCompile("Unable to set database configuration"),
+			},
+		},
+	})
+}
+
+func TestDatabaseConfigurationResourceReadRemovesDeletedDatabase(t *testing.T) {
+	config, _, faults := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + testDatabaseConfigurationResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					faults.Fail(client.GetDatabaseConfigurationOperation, -1, http.StatusNotFound)
+				},
+				Config:             config + testDatabaseConfigurationResourceConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestDatabaseConfigurationResourceConflictsWithDatabase(t *testing.T) {
+	config, _, _ := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Both resources manage block_writes, so the database sees it changed
+				// on refresh and plans to set it back.
+				Config: config + `
+				resource "turso_database" "test" {
+					organization_name = "jpedroh"
+					name              = "tf-provider-resource"
+					block_writes      = true
+				}
+
+				resource "turso_database_configuration" "test" {
+					organization_slug = "jpedroh"
+					database_name     = turso_database.test.name
+					size_limit        = "1gb"
+					block_reads       = false
+					block_writes      = false
+					delete_protection = false
+				}`,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
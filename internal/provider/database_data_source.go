@@ -6,10 +6,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
 	"terraform-provider-turso/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -30,12 +33,20 @@ type DatabaseDataSourceModel struct {
 	Name             types.String `tfsdk:"name"`
 	Group            types.String `tfsdk:"group"`
 	SizeLimit        types.String `tfsdk:"size_limit"`
-	IsSchema         types.Bool   `tfsdk:"is_schema"`
-	Schema           types.String `tfsdk:"schema"`
+	ListChildren     types.Bool   `tfsdk:"list_children"`
 
 	// Computed
-	DbId     types.String `tfsdk:"db_id"`
-	Hostname types.String `tfsdk:"hostname"`
+	DbId             types.String   `tfsdk:"db_id"`
+	Hostname         types.String   `tfsdk:"hostname"`
+	IsSchema         types.Bool     `tfsdk:"is_schema"`
+	Schema           types.String   `tfsdk:"schema"`
+	Children         []types.String `tfsdk:"children"`
+	PrimaryRegion    types.String   `tfsdk:"primary_region"`
+	Regions          types.List     `tfsdk:"regions"`
+	BlockReads       types.Bool     `tfsdk:"block_reads"`
+	BlockWrites      types.Bool     `tfsdk:"block_writes"`
+	DeleteProtection types.Bool     `tfsdk:"delete_protection"`
+	Parent           types.Object   `tfsdk:"parent"`
 }
 
 func (d *DatabaseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -56,20 +67,73 @@ func (d *DatabaseDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				Required:            true,
 			},
 			"group": schema.StringAttribute{
-				MarkdownDescription: "The name of the group where the database should be created. The group must already exist.",
+				MarkdownDescription: "The name of the group the database belongs to. If set, reading fails when the database belongs to another group.",
 				Optional:            true,
+				Computed:            true,
 			},
 			"size_limit": schema.StringAttribute{
 				MarkdownDescription: "The maximum size of the database in bytes. Values with units are also accepted, e.g. 1mb, 256mb, 1gb.",
 				Optional:            true,
 			},
-			"is_schema": schema.BoolAttribute{
-				MarkdownDescription: "Mark this database as the parent schema database that updates child databases with any schema changes.",
+			"list_children": schema.BoolAttribute{
+				MarkdownDescription: "List the databases that use this database as their schema in `children`. Only schema databases have children.",
 				Optional:            true,
 			},
+			"is_schema": schema.BoolAttribute{
+				MarkdownDescription: "Whether this database is a parent schema database that updates child databases with any schema changes. " +
+					"A configured value is kept, with a warning if it does not match the database.",
+				Optional: true,
+				Computed: true,
+			},
 			"schema": schema.StringAttribute{
-				MarkdownDescription: "The name of the parent database to use as the schema.",
-				Optional:            true,
+				MarkdownDescription: "The name of the parent schema database, if this database uses one. " +
+					"A configured value is kept, with a warning if it does not match the database.",
+				Optional: true,
+				Computed: true,
+			},
+			"primary_region": schema.StringAttribute{
+				MarkdownDescription: "The location code of the primary region of the group the database belongs to.",
+				Computed:            true,
+			},
+			"regions": schema.ListAttribute{
+				MarkdownDescription: "The location codes of the regions of the group the database belongs to.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"block_reads": schema.BoolAttribute{
+				MarkdownDescription: "Whether reads are blocked for the database.",
+				Computed:            true,
+			},
+			"block_writes": schema.BoolAttribute{
+				MarkdownDescription: "Whether writes are blocked for the database.",
+				Computed:            true,
+			},
+			"delete_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether the database is protected from deletion.",
+				Computed:            true,
+			},
+			"parent": schema.SingleNestedAttribute{
+				MarkdownDescription: "The database this database was branched from, if any.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "The universal unique identifier (UUID) of the parent database.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the parent database.",
+						Computed:            true,
+					},
+					"branched_at": schema.StringAttribute{
+						MarkdownDescription: "When the database was branched from the parent, as an RFC 3339 timestamp.",
+						Computed:            true,
+					},
+				},
+			},
+			"children": schema.ListAttribute{
+				MarkdownDescription: "The names of the databases using this database as their schema, sorted by name. Only set when `list_children` is true.",
+				ElementType:         types.StringType,
+				Computed:            true,
 			},
 			"db_id": schema.StringAttribute{
 				MarkdownDescription: "The database universal unique identifier (UUID).",
@@ -127,8 +191,73 @@ func (d *DatabaseDataSource) Read(ctx context.Context, req datasource.ReadReques
 	case *client.GetDatabaseOK:
 		data.DbId = types.StringValue(string(p.Database.Value.DbId.Value))         //nolint:all
 		data.Hostname = types.StringValue(string(p.Database.Value.Hostname.Value)) //nolint:all
+		// Configured values cannot be changed by a data source, so they are only
+		// compared with the database.
+		resp.Diagnostics.Append(keepConfiguredValue("is_schema", data.Name.ValueString(), &data.IsSchema, types.BoolValue(p.Database.Value.IsSchema.Value))...)
+		resp.Diagnostics.Append(keepConfiguredValue("schema", data.Name.ValueString(), &data.Schema, databaseSchema(p.Database.Value))...)
+		data.PrimaryRegion = types.StringValue(p.Database.Value.PrimaryRegion.Value)
+		data.BlockReads = types.BoolValue(p.Database.Value.BlockReads.Value)
+		data.BlockWrites = types.BoolValue(p.Database.Value.BlockWrites.Value)
+		data.DeleteProtection = types.BoolValue(p.Database.Value.DeleteProtection.Value)
+
+		var diags diag.Diagnostics
+		data.Regions, diags = databaseRegions(p.Database.Value)
+		resp.Diagnostics.Append(diags...)
+		data.Parent, diags = databaseParent(p.Database.Value)
+		resp.Diagnostics.Append(diags...)
+
+		group := p.Database.Value.Group.Value
+		if !data.Group.IsNull() && data.Group.ValueString() != group {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Database %s belongs to group %s, not %s", data.Name.ValueString(), group, data.Group.ValueString()))
+			return
+		}
+		data.Group = types.StringValue(group)
+	case *client.DatabaseNotFoundResponse:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database, got error: %s", p.Error.Value))
+		return
+	}
+
+	if data.ListChildren.ValueBool() {
+		children, err := d.client.ListDatabases(ctx, client.ListDatabasesParams{
+			OrganizationSlug: data.OrganizationName.ValueString(),
+			Schema:           client.NewOptString(data.Name.ValueString()),
+		})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list child databases, got error: %s", err.Error()))
+			return
+		}
+
+		data.Children = []types.String{}
+		for _, child := range children.Databases {
+			data.Children = append(data.Children, types.StringValue(child.Name.Value))
+		}
+
+		sort.Slice(data.Children, func(i, j int) bool {
+			return data.Children[i].ValueString() < data.Children[j].ValueString()
+		})
 	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// keepConfiguredValue sets value to current unless it was configured, in which
+// case a mismatch with current is reported as a warning.
+func keepConfiguredValue[T attr.Value](name, databaseName string, value *T, current T) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if (*value).IsNull() {
+		*value = current
+		return diags
+	}
+
+	if !(*value).Equal(current) {
+		diags.AddWarning(
+			"Database Attribute Mismatch",
+			fmt.Sprintf("The configured %s of database %s is %s, but the database has %s.", name, databaseName, (*value).String(), current.String()),
+		)
+	}
+
+	return diags
+}
@@ -4,6 +4,9 @@
 package provider
 
 import (
+	"context"
+	"regexp"
+	"terraform-provider-turso/internal/client"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -23,6 +26,88 @@ func TestAccDatabaseDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.turso_database.tf_provider_data_source", "organization_name", "jpedroh"),
 					resource.TestCheckResourceAttr("data.turso_database.tf_provider_data_source", "hostname", "tfproviderdatasource-jpedroh.aws-us-east-1.turso.io"),
 					resource.TestCheckResourceAttrSet("data.turso_database.tf_provider_data_source", "db_id"),
+					resource.TestCheckResourceAttr("data.turso_database.tf_provider_data_source", "group", "default"),
+					resource.TestCheckResourceAttr("data.turso_database.tf_provider_data_source", "primary_region", "aws-us-east-1"),
+					resource.TestCheckResourceAttr("data.turso_database.tf_provider_data_source", "regions.#", "1"),
+					resource.TestCheckResourceAttr("data.turso_database.tf_provider_data_source", "delete_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatabaseDataSourceParent(t *testing.T) {
+	config, handler, _ := newFaultyProviderConfig(t, 0)
+
+	_, err := handler.CreateDatabase(context.Background(), &client.CreateDatabaseInput{
+		Name:  "tfproviderbranch",
+		Group: "default",
+		Seed: client.NewOptCreateDatabaseInputSeed(client.CreateDatabaseInputSeed{
+			Type: client.NewOptCreateDatabaseInputSeedType(client.CreateDatabaseInputSeedTypeDatabase),
+			Name: client.NewOptString("tfproviderdatasource"),
+		}),
+	}, client.CreateDatabaseParams{OrganizationSlug: "jpedroh"})
+	if err != nil {
+		t.Fatalf("unable to branch database: %s", err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				data "turso_database" "branch" {
+					organization_name = "jpedroh"
+					name              = "tfproviderbranch"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.turso_database.branch", "parent.name", "tfproviderdatasource"),
+					resource.TestCheckResourceAttrSet("data.turso_database.branch", "parent.id"),
+					resource.TestCheckResourceAttrSet("data.turso_database.branch", "parent.branched_at"),
+				),
+			},
+			{
+				Config: config + `
+				data "turso_database" "branch" {
+					organization_name = "jpedroh"
+					name              = "tfproviderbranch"
+					group             = "other"
+				}`,
+				ExpectError: regexp.MustCompile(`Database tfproviderbranch belongs to group default, not other`),
+			},
+		},
+	})
+}
+
+func TestDatabaseDataSourceConfiguredSchema(t *testing.T) {
+	config, _, _ := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Configurations that set is_schema and schema keep working.
+				Config: config + `
+				data "turso_database" "test" {
+					organization_name = "jpedroh"
+					name              = "tfproviderdatasource"
+					is_schema         = false
+					schema            = "tfproviderschema"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.turso_database.test", "is_schema", "false"),
+					resource.TestCheckResourceAttr("data.turso_database.test", "schema", "tfproviderschema"),
+				),
+			},
+			{
+				Config: config + `
+				data "turso_database" "test" {
+					organization_name = "jpedroh"
+					name              = "tfproviderdatasource"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.turso_database.test", "is_schema", "false"),
+					resource.TestCheckNoResourceAttr("data.turso_database.test", "schema"),
 				),
 			},
 		},
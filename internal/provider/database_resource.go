@@ -8,10 +8,18 @@ import (
 	"fmt"
 	"strings"
 	"terraform-provider-turso/internal/client"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -22,6 +30,23 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &DatabaseResource{}
 var _ resource.ResourceWithImportState = &DatabaseResource{}
+var _ resource.ResourceWithValidateConfig = &DatabaseResource{}
+
+const (
+	defaultDatabaseCreateTimeout = 20 * time.Minute
+	defaultDatabaseReadTimeout   = 5 * time.Minute
+	defaultDatabaseUpdateTimeout = 20 * time.Minute
+	defaultDatabaseDeleteTimeout = 20 * time.Minute
+)
+
+const (
+	defaultWaitForReady    = true
+	defaultWaitForReplicas = false
+)
+
+// databaseReadyPollInterval is how often the instances of a newly created
+// database are polled while waiting for them to become ready.
+var databaseReadyPollInterval = 2 * time.Second
 
 func NewDatabaseResource() resource.Resource {
 	return &DatabaseResource{}
@@ -32,14 +57,33 @@ type DatabaseResource struct {
 }
 
 type DatabaseResourceModel struct {
-	OrganizationName types.String `tfsdk:"organization_name"`
-	Name             types.String `tfsdk:"name"`
-	Group            types.String `tfsdk:"group"`
-	SizeLimit        types.String `tfsdk:"size_limit"`
+	OrganizationName types.String   `tfsdk:"organization_name"`
+	Name             types.String   `tfsdk:"name"`
+	Group            types.String   `tfsdk:"group"`
+	SizeLimit        types.String   `tfsdk:"size_limit"`
+	IsSchema         types.Bool     `tfsdk:"is_schema"`
+	Schema           types.String   `tfsdk:"schema"`
+	DeleteProtection types.Bool     `tfsdk:"delete_protection"`
+	BlockReads       types.Bool     `tfsdk:"block_reads"`
+	BlockWrites      types.Bool     `tfsdk:"block_writes"`
+	AllowAttach      types.Bool     `tfsdk:"allow_attach"`
+	WaitForReady     types.Bool     `tfsdk:"wait_for_ready"`
+	WaitForReplicas  types.Bool     `tfsdk:"wait_for_replicas"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 
 	// Computed
-	DbId     types.String `tfsdk:"db_id"`
-	Hostname types.String `tfsdk:"hostname"`
+	DbId          types.String `tfsdk:"db_id"`
+	Hostname      types.String `tfsdk:"hostname"`
+	PrimaryRegion types.String `tfsdk:"primary_region"`
+	Regions       types.List   `tfsdk:"regions"`
+	Parent        types.Object `tfsdk:"parent"`
+}
+
+// databaseParentAttrTypes are the attributes of the parent of a database.
+var databaseParentAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"name":        types.StringType,
+	"branched_at": types.StringType,
 }
 
 func (r *DatabaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,7 +92,8 @@ func (r *DatabaseResource) Metadata(ctx context.Context, req resource.MetadataRe
 
 func (r *DatabaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Database resource",
+		MarkdownDescription: "Database resource. Its settings, such as `delete_protection`, may be managed here or with " +
+			"`turso_database_configuration`, but not both for the same database, as the two overwrite each other.",
 
 		Attributes: map[string]schema.Attribute{
 			"organization_name": schema.StringAttribute{
@@ -66,10 +111,11 @@ func (r *DatabaseResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"group": schema.StringAttribute{
-				MarkdownDescription: "The name of the group where the database should be created. The group must already exist.",
-				Optional:            true,
-				Computed:            true,
-				Default:             stringdefault.StaticString("default"),
+				MarkdownDescription: "The name of the group where the database should be created. The group must already exist. " +
+					"Moving the database to another group outside of Terraform is reported as a warning and does not replace it.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("default"),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -78,6 +124,66 @@ func (r *DatabaseResource) Schema(ctx context.Context, req resource.SchemaReques
 				MarkdownDescription: "The maximum size of the database in bytes. Values with units are also accepted, e.g. 1mb, 256mb, 1gb.",
 				Optional:            true,
 			},
+			"is_schema": schema.BoolAttribute{
+				MarkdownDescription: "Mark this database as the parent schema database that updates child databases with any schema changes. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "The name of the parent schema database this database is created from and kept in sync with.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"delete_protection": schema.BoolAttribute{
+				MarkdownDescription: "Protect the database from deletion. If not set, the current setting is kept.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"block_reads": schema.BoolAttribute{
+				MarkdownDescription: "Block all database reads. If not set, the current setting is kept.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"block_writes": schema.BoolAttribute{
+				MarkdownDescription: "Block all database writes. If not set, the current setting is kept.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_attach": schema.BoolAttribute{
+				MarkdownDescription: "Allow other databases to attach this database. If not set, the current setting is kept.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				MarkdownDescription: "Wait after creation until the primary instance of the database reports a hostname. The wait is bounded by the `create` timeout. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(defaultWaitForReady),
+			},
+			"wait_for_replicas": schema.BoolAttribute{
+				MarkdownDescription: "When `wait_for_ready` is enabled, also wait until every replica instance reports a hostname. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(defaultWaitForReplicas),
+			},
 			"db_id": schema.StringAttribute{
 				MarkdownDescription: "The database universal unique identifier (UUID).",
 				Computed:            true,
@@ -92,6 +198,50 @@ func (r *DatabaseResource) Schema(ctx context.Context, req resource.SchemaReques
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"primary_region": schema.StringAttribute{
+				MarkdownDescription: "The location code of the primary region of the group the database belongs to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"regions": schema.ListAttribute{
+				MarkdownDescription: "The location codes of the regions of the group the database belongs to.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"parent": schema.SingleNestedAttribute{
+				MarkdownDescription: "The database this database was branched from, if any.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "The universal unique identifier (UUID) of the parent database.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the parent database.",
+						Computed:            true,
+					},
+					"branched_at": schema.StringAttribute{
+						MarkdownDescription: "When the database was branched from the parent, as an RFC 3339 timestamp.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -115,6 +265,24 @@ func (r *DatabaseResource) Configure(ctx context.Context, req resource.Configure
 	r.client = client
 }
 
+func (r *DatabaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DatabaseResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.IsSchema.ValueBool() && !data.Schema.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("schema"),
+			"Invalid Attribute Combination",
+			"A schema database cannot itself use a schema, so `schema` cannot be set when `is_schema` is true.",
+		)
+	}
+}
+
 func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data DatabaseResourceModel
 
@@ -125,11 +293,28 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	res, err := r.client.CreateDatabase(ctx, &client.CreateDatabaseInput{
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultDatabaseCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	input := &client.CreateDatabaseInput{
 		Name:      data.Name.ValueString(),
 		Group:     data.Group.ValueString(),
 		SizeLimit: client.NewOptString(data.SizeLimit.ValueString()),
-	}, client.CreateDatabaseParams{
+		IsSchema:  client.NewOptBool(data.IsSchema.ValueBool()),
+	}
+
+	if !data.Schema.IsNull() {
+		input.Schema = client.NewOptString(data.Schema.ValueString())
+	}
+
+	res, err := r.client.CreateDatabase(ctx, input, client.CreateDatabaseParams{
 		OrganizationSlug: data.OrganizationName.ValueString(),
 	})
 
@@ -141,10 +326,51 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 	// For the purposes of this example code, hardcoding a response value to
 	// save into the Terraform state.
 
+	var created client.CreateDatabaseOutput
+
 	switch p := res.(type) {
 	case *client.CreateDatabaseOK:
-		data.DbId = types.StringValue(string(p.Database.Value.DbId.Value))
-		data.Hostname = types.StringValue(string(p.Database.Value.Hostname.Value))
+		created = p.Database.Value
+	case *client.CreateDatabaseBadRequest:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create database, got error: %s", p.Error.Value))
+		return
+	case *client.CreateDatabaseConflict:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create database, got error: %s", p.Error.Value))
+		return
+	}
+
+	// Settings that cannot be passed on creation are applied right after it.
+	configuration, err := r.updateConfiguration(ctx, &data, false)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Database was created but its configuration could not be applied, got error: %s", err.Error()))
+	}
+
+	// The create response only includes the ID and hostname, so the rest of
+	// the database is read back.
+	db, err := readDatabase(ctx, r.client, data.OrganizationName.ValueString(), data.Name.ValueString())
+
+	if err != nil {
+		// The database exists, so it is still saved to the state, which marks
+		// it as tainted.
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Database was created but could not be read, got error: %s", err.Error()))
+		db = &client.Database{
+			DbId:     client.NewOptString(string(created.DbId.Value)),
+			Hostname: client.NewOptString(string(created.Hostname.Value)),
+			Group:    client.NewOptString(data.Group.ValueString()),
+			IsSchema: client.NewOptBool(data.IsSchema.ValueBool()),
+			Schema:   client.NewOptString(data.Schema.ValueString()),
+		}
+	}
+
+	resp.Diagnostics.Append(data.setDatabase(*db)...)
+
+	if configuration != nil {
+		data.setConfiguration(*configuration)
+	} else {
+		// The planned settings are kept, as the resource is tainted and they
+		// are applied again when it is replaced.
+		data.clearUnknownConfiguration()
 	}
 
 	// Write logs using the tflog package
@@ -153,6 +379,21 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || !data.WaitForReady.ValueBool() {
+		return
+	}
+
+	// The database already exists at this point, so a failure to become ready
+	// is reported after saving the state, which marks the resource as tainted.
+	err = waitForDatabaseReady(ctx, r.client, data.OrganizationName.ValueString(), data.Name.ValueString(), data.WaitForReplicas.ValueBool())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Database Not Ready", fmt.Sprintf("Database was created but did not become ready, got error: %s", err.Error()))
+		return
+	}
+
+	tflog.Trace(ctx, "database resource is ready")
 }
 
 func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -165,6 +406,25 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultDatabaseReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// State written by versions without these provider-only attributes has no
+	// value for them, which would otherwise plan an update after upgrading.
+	if data.WaitForReady.IsNull() {
+		data.WaitForReady = types.BoolValue(defaultWaitForReady)
+	}
+	if data.WaitForReplicas.IsNull() {
+		data.WaitForReplicas = types.BoolValue(defaultWaitForReplicas)
+	}
+
 	res, err := r.client.GetDatabase(ctx, client.GetDatabaseParams{
 		OrganizationSlug: data.OrganizationName.ValueString(),
 		DatabaseName:     data.Name.ValueString(),
@@ -177,36 +437,88 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	switch p := res.(type) {
 	case *client.GetDatabaseOK:
-		data.DbId = types.StringValue(p.Database.Value.DbId.Value)
-		data.Hostname = types.StringValue(p.Database.Value.Hostname.Value)
+		// The configured group is kept, as replacing the database would
+		// destroy its data. Moving it back is left to the user.
+		if group := p.Database.Value.Group.Value; group != "" && group != data.Group.ValueString() {
+			resp.Diagnostics.AddWarning(
+				"Database Group Changed",
+				fmt.Sprintf("Database %s was moved from group %s to group %s outside of Terraform. "+
+					"It is not replaced; move it back or update the configuration to match.", data.Name.ValueString(), data.Group.ValueString(), group),
+			)
+		}
+		resp.Diagnostics.Append(data.setDatabase(p.Database.Value)...)
+	case *client.DatabaseNotFoundResponse:
+		// The database was deleted outside of Terraform, so it is removed from
+		// the state and recreated on the next apply.
+		tflog.Warn(ctx, fmt.Sprintf("Database %s not found, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	configuration, err := r.client.GetDatabaseConfiguration(ctx, client.GetDatabaseConfigurationParams{
+		OrganizationSlug: data.OrganizationName.ValueString(),
+		DatabaseName:     data.Name.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database configuration, got error: %s", err.Error()))
+		return
+	}
+
+	changed := changedDatabaseSettings([]databaseSetting{
+		{"delete_protection", data.DeleteProtection, types.BoolValue(configuration.DeleteProtection.Value)},
+		{"block_reads", data.BlockReads, types.BoolValue(configuration.BlockReads.Value)},
+		{"block_writes", data.BlockWrites, types.BoolValue(configuration.BlockWrites.Value)},
+		{"allow_attach", data.AllowAttach, types.BoolValue(configuration.AllowAttach.Value)},
+	})
+	if len(changed) > 0 {
+		resp.Diagnostics.Append(conflictingDatabaseSettingsWarning("turso_database", data.OrganizationName.ValueString(), data.Name.ValueString(), changed))
 	}
 
+	data.setConfiguration(*configuration)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data DatabaseResourceModel
+	var data, state DatabaseResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	_, err := r.client.UpdateDatabaseConfiguration(ctx, &client.DatabaseConfigurationInput{
-		SizeLimit: client.NewOptString(data.SizeLimit.ValueString()),
-	}, client.UpdateDatabaseConfigurationParams{
-		OrganizationSlug: data.OrganizationName.ValueString(),
-		DatabaseName:     data.Name.ValueString(),
-	})
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultDatabaseUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update database, got error: %s", err.Error()))
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Attributes such as wait_for_ready only affect the provider, so the API is
+	// only called when a setting of the database changed.
+	sizeLimitChanged := !data.SizeLimit.Equal(state.SizeLimit)
+	settingsChanged := !data.DeleteProtection.Equal(state.DeleteProtection) || !data.BlockReads.Equal(state.BlockReads) ||
+		!data.BlockWrites.Equal(state.BlockWrites) || !data.AllowAttach.Equal(state.AllowAttach)
+
+	if sizeLimitChanged || settingsChanged {
+		configuration, err := r.updateConfiguration(ctx, &data, sizeLimitChanged)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update database, got error: %s", err.Error()))
+			return
+		}
+
+		data.setConfiguration(*configuration)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -221,7 +533,17 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	_, err := r.client.DeleteDatabase(ctx, client.DeleteDatabaseParams{
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDatabaseDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	res, err := r.client.DeleteDatabase(ctx, client.DeleteDatabaseParams{
 		OrganizationSlug: data.OrganizationName.ValueString(),
 		DatabaseName:     data.Name.ValueString(),
 	})
@@ -230,6 +552,10 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete database, got error: %s", err.Error()))
 		return
 	}
+
+	if _, ok := res.(*client.DatabaseNotFoundResponse); ok {
+		tflog.Warn(ctx, fmt.Sprintf("Database %s was already deleted", data.Name.ValueString()))
+	}
 }
 
 func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -265,5 +591,209 @@ func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportS
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), types.StringValue(p.Database.Value.Group.Value))...)
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("db_id"), types.StringValue(p.Database.Value.DbId.Value))...)
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostname"), types.StringValue(p.Database.Value.Hostname.Value))...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("is_schema"), types.BoolValue(p.Database.Value.IsSchema.Value))...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schema"), databaseSchema(p.Database.Value))...)
+	case *client.DatabaseNotFoundResponse:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import database, got error: %s", p.Error.Value))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_ready"), defaultWaitForReady)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_replicas"), defaultWaitForReplicas)...)
+}
+
+// updateConfiguration sends every known setting of data, and the size limit
+// if includeSizeLimit is set, so that settings are never reset by omission.
+// If there is nothing to send, the current configuration is returned.
+func (r *DatabaseResource) updateConfiguration(ctx context.Context, data *DatabaseResourceModel, includeSizeLimit bool) (*client.DatabaseConfigurationResponse, error) {
+	input := &client.DatabaseConfigurationInput{}
+	update := false
+
+	for _, setting := range []struct {
+		value types.Bool
+		opt   *client.OptBool
+	}{
+		{data.DeleteProtection, &input.DeleteProtection},
+		{data.BlockReads, &input.BlockReads},
+		{data.BlockWrites, &input.BlockWrites},
+		{data.AllowAttach, &input.AllowAttach},
+	} {
+		if setting.value.IsNull() || setting.value.IsUnknown() {
+			continue
+		}
+
+		*setting.opt = client.NewOptBool(setting.value.ValueBool())
+		update = true
+	}
+
+	if includeSizeLimit {
+		input.SizeLimit = client.NewOptString(data.SizeLimit.ValueString())
+		update = true
+	}
+
+	if !update {
+		return r.client.GetDatabaseConfiguration(ctx, client.GetDatabaseConfigurationParams{
+			OrganizationSlug: data.OrganizationName.ValueString(),
+			DatabaseName:     data.Name.ValueString(),
+		})
 	}
+
+	return r.client.UpdateDatabaseConfiguration(ctx, input, client.UpdateDatabaseConfigurationParams{
+		OrganizationSlug: data.OrganizationName.ValueString(),
+		DatabaseName:     data.Name.ValueString(),
+	})
+}
+
+// readDatabase returns the database, or an error if it does not exist.
+func readDatabase(ctx context.Context, c *client.Client, organizationSlug, name string) (*client.Database, error) {
+	res, err := c.GetDatabase(ctx, client.GetDatabaseParams{
+		OrganizationSlug: organizationSlug,
+		DatabaseName:     name,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch p := res.(type) {
+	case *client.GetDatabaseOK:
+		return &p.Database.Value, nil
+	case *client.DatabaseNotFoundResponse:
+		return nil, fmt.Errorf("%s", p.Error.Value)
+	}
+
+	return nil, fmt.Errorf("unexpected response %T", res)
+}
+
+// setDatabase sets the attributes that are read from the API.
+func (data *DatabaseResourceModel) setDatabase(db client.Database) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.DbId = types.StringValue(db.DbId.Value)
+	data.Hostname = types.StringValue(db.Hostname.Value)
+	data.IsSchema = types.BoolValue(db.IsSchema.Value)
+	data.Schema = databaseSchema(db)
+	data.PrimaryRegion = types.StringValue(db.PrimaryRegion.Value)
+
+	data.Regions, diags = databaseRegions(db)
+	if diags.HasError() {
+		return diags
+	}
+
+	var parentDiags diag.Diagnostics
+	data.Parent, parentDiags = databaseParent(db)
+	diags.Append(parentDiags...)
+
+	return diags
+}
+
+// setConfiguration sets the settings that are managed through the database
+// configuration.
+func (data *DatabaseResourceModel) setConfiguration(configuration client.DatabaseConfigurationResponse) {
+	data.DeleteProtection = types.BoolValue(configuration.DeleteProtection.Value)
+	data.BlockReads = types.BoolValue(configuration.BlockReads.Value)
+	data.BlockWrites = types.BoolValue(configuration.BlockWrites.Value)
+	data.AllowAttach = types.BoolValue(configuration.AllowAttach.Value)
+}
+
+// clearUnknownConfiguration sets the settings that were not configured, and so
+// are unknown until the configuration is read, to null.
+func (data *DatabaseResourceModel) clearUnknownConfiguration() {
+	for _, setting := range []*types.Bool{&data.DeleteProtection, &data.BlockReads, &data.BlockWrites, &data.AllowAttach} {
+		if setting.IsUnknown() {
+			*setting = types.BoolNull()
+		}
+	}
+}
+
+// databaseRegions returns the regions of db as a list.
+func databaseRegions(db client.Database) (types.List, diag.Diagnostics) {
+	regions := []attr.Value{}
+	for _, region := range db.Regions {
+		regions = append(regions, types.StringValue(region))
+	}
+
+	return types.ListValue(types.StringType, regions)
+}
+
+// databaseParent returns the database db was branched from, or null if it was
+// not branched.
+func databaseParent(db client.Database) (types.Object, diag.Diagnostics) {
+	if !db.Parent.Set || db.Parent.Null {
+		return types.ObjectNull(databaseParentAttrTypes), nil
+	}
+
+	parent := db.Parent.Value
+	branchedAt := types.StringNull()
+	if parent.BranchedAt.Set {
+		branchedAt = types.StringValue(parent.BranchedAt.Value.Format(time.RFC3339Nano))
+	}
+
+	return types.ObjectValue(databaseParentAttrTypes, map[string]attr.Value{
+		"id":          types.StringValue(parent.ID.Value),
+		"name":        types.StringValue(parent.Name.Value),
+		"branched_at": branchedAt,
+	})
+}
+
+// databaseSchema returns the name of the parent schema database of db, or null
+// if it does not use one.
+func databaseSchema(db client.Database) types.String {
+	if db.Schema.Value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(db.Schema.Value)
+}
+
+// waitForDatabaseReady polls the instances of a database until the primary
+// instance (and, if allInstances is set, every replica) reports a hostname,
+// or ctx is done.
+func waitForDatabaseReady(ctx context.Context, c *client.Client, organizationSlug, databaseName string, allInstances bool) error {
+	ticker := time.NewTicker(databaseReadyPollInterval)
+	defer ticker.Stop()
+
+	for {
+		res, err := c.ListDatabaseInstances(ctx, client.ListDatabaseInstancesParams{
+			OrganizationSlug: organizationSlug,
+			DatabaseName:     databaseName,
+		})
+
+		if err == nil && databaseInstancesReady(res.Instances, allInstances) {
+			return nil
+		}
+
+		if err != nil {
+			tflog.Debug(ctx, fmt.Sprintf("Unable to list instances of database %s/%s: %s", organizationSlug, databaseName, err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			if err != nil {
+				return fmt.Errorf("%w (last error: %s)", ctx.Err(), err.Error())
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// databaseInstancesReady reports whether the primary instance, and if
+// allInstances is set every other instance, has a hostname.
+func databaseInstancesReady(instances []client.Instance, allInstances bool) bool {
+	primaryReady := false
+
+	for _, instance := range instances {
+		ready := instance.Hostname.Value != ""
+
+		if instance.Type.Value == client.InstanceTypePrimary && ready {
+			primaryReady = true
+		}
+
+		if allInstances && !ready {
+			return false
+		}
+	}
+
+	return primaryReady
 }
@@ -4,9 +4,18 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"terraform-provider-turso/internal/client"
+	"terraform-provider-turso/internal/fakeapi"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccDatabaseResource(t *testing.T) {
@@ -26,6 +35,14 @@ func TestAccDatabaseResource(t *testing.T) {
 					resource.TestCheckResourceAttr("turso_database.test", "group", "default"),
 					resource.TestCheckResourceAttr("turso_database.test", "hostname", "tf-provider-resource-jpedroh.aws-us-east-1.turso.io"),
 					resource.TestCheckResourceAttrSet("turso_database.test", "db_id"),
+					resource.TestCheckResourceAttr("turso_database.test", "primary_region", "aws-us-east-1"),
+					resource.TestCheckResourceAttr("turso_database.test", "regions.#", "1"),
+					resource.TestCheckResourceAttr("turso_database.test", "regions.0", "aws-us-east-1"),
+					resource.TestCheckResourceAttr("turso_database.test", "block_reads", "false"),
+					resource.TestCheckResourceAttr("turso_database.test", "block_writes", "false"),
+					resource.TestCheckResourceAttr("turso_database.test", "delete_protection", "false"),
+					resource.TestCheckResourceAttr("turso_database.test", "allow_attach", "false"),
+					resource.TestCheckNoResourceAttr("turso_database.test", "parent"),
 				),
 			},
 			{
@@ -60,3 +77,414 @@ func TestAccDatabaseResourceGroupIsOptional(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDatabaseResourceSchema(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "turso_database" "schema" {
+					organization_name = "jpedroh"
+					name              = "tf-provider-schema"
+					is_schema         = true
+				}
+
+				resource "turso_database" "child" {
+					organization_name = "jpedroh"
+					name              = "tf-provider-child"
+					schema            = turso_database.schema.name
+				}
+
+				data "turso_database" "schema" {
+					organization_name = "jpedroh"
+					name              = turso_database.schema.name
+					list_children     = true
+
+					depends_on = [turso_database.child]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("turso_database.schema", "is_schema", "true"),
+					resource.TestCheckNoResourceAttr("turso_database.schema", "schema"),
+					resource.TestCheckResourceAttr("turso_database.child", "is_schema", "false"),
+					resource.TestCheckResourceAttr("turso_database.child", "schema", "tf-provider-schema"),
+					resource.TestCheckResourceAttr("data.turso_database.schema", "is_schema", "true"),
+					resource.TestCheckResourceAttr("data.turso_database.schema", "children.#", "1"),
+					resource.TestCheckResourceAttr("data.turso_database.schema", "children.0", "tf-provider-child"),
+				),
+			},
+			{
+				ResourceName:                         "turso_database.child",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "jpedroh/tf-provider-child",
+				ImportStateVerifyIdentifierAttribute: "db_id",
+			},
+		},
+	})
+}
+
+func TestDatabaseResourceSchemaConflictsWithIsSchema(t *testing.T) {
+	config, _, _ := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				resource "turso_database" "test" {
+					organization_name = "jpedroh"
+					name              = "tf-provider-resource"
+					is_schema         = true
+					schema            = "tfproviderdatasource"
+				}`,
+				ExpectError: regexp.MustCompile(`A schema database cannot itself use a schema`),
+			},
+		},
+	})
+}
+
+func TestDatabaseResourceReadKeepsGroupOnChange(t *testing.T) {
+	config, handler, _ := newFaultyProviderConfig(t, 0)
+	config += testDatabaseResourceConfig
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("turso_database.test", "group", "default"),
+			},
+			{
+				PreConfig: func() {
+					if err := handler.AddGroup("jpedroh", "other", "aws-eu-west-1"); err != nil {
+						t.Fatalf("unable to add group: %s", err)
+					}
+					if err := handler.MoveDatabase("jpedroh", "tf-provider-resource", "other"); err != nil {
+						t.Fatalf("unable to move database: %s", err)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("turso_database.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("turso_database.test", "group", "default"),
+					resource.TestCheckResourceAttr("turso_database.test", "primary_region", "aws-eu-west-1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseResourceSettings(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "turso_database" "test" {
+					organization_name = "jpedroh"
+					name              = "tf-provider-resource"
+					delete_protection = true
+					block_writes      = true
+					allow_attach      = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("turso_database.test", "delete_protection", "true"),
+					resource.TestCheckResourceAttr("turso_database.test", "block_reads", "false"),
+					resource.TestCheckResourceAttr("turso_database.test", "block_writes", "true"),
+					resource.TestCheckResourceAttr("turso_database.test", "allow_attach", "true"),
+				),
+			},
+			{
+				ResourceName:                         "turso_database.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "jpedroh/tf-provider-resource",
+				ImportStateVerifyIdentifierAttribute: "db_id",
+			},
+			{
+				// Delete protection is turned off so that the database can be
+				// destroyed, while the settings that are no longer set are kept.
+				Config: providerConfig + `
+				resource "turso_database" "test" {
+					organization_name = "jpedroh"
+					name              = "tf-provider-resource"
+					delete_protection = false
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("turso_database.test", "delete_protection", "false"),
+					resource.TestCheckResourceAttr("turso_database.test", "block_writes", "true"),
+					resource.TestCheckResourceAttr("turso_database.test", "allow_attach", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseResourceTimeouts(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "turso_database" "test" {
+					organization_name = "jpedroh"
+					name	  = "tf-provider-resource"
+
+					timeouts {
+						create = "30m"
+						delete = "10m"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("turso_database.test", "timeouts.create", "30m"),
+					resource.TestCheckResourceAttr("turso_database.test", "timeouts.delete", "10m"),
+					resource.TestCheckResourceAttrSet("turso_database.test", "db_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatabaseInstancesReady(t *testing.T) {
+	primary := client.Instance{Type: client.NewOptInstanceType(client.InstanceTypePrimary), Hostname: client.NewOptString("db-org.turso.io")}
+	pendingPrimary := client.Instance{Type: client.NewOptInstanceType(client.InstanceTypePrimary)}
+	replica := client.Instance{Type: client.NewOptInstanceType(client.InstanceTypeReplica), Hostname: client.NewOptString("replica.db-org.turso.io")}
+	pendingReplica := client.Instance{Type: client.NewOptInstanceType(client.InstanceTypeReplica)}
+
+	cases := []struct {
+		name         string
+		instances    []client.Instance
+		allInstances bool
+		expected     bool
+	}{
+		{"no instances", nil, false, false},
+		{"primary pending", []client.Instance{pendingPrimary, replica}, false, false},
+		{"primary ready", []client.Instance{primary, pendingReplica}, false, true},
+		{"replica pending", []client.Instance{primary, pendingReplica}, true, false},
+		{"all ready", []client.Instance{primary, replica}, true, true},
+	}
+
+	for _, c := range cases {
+		if actual := databaseInstancesReady(c.instances, c.allInstances); actual != c.expected {
+			t.Errorf("%s: expected %t, got %t", c.name, c.expected, actual)
+		}
+	}
+}
+
+const testDatabaseResourceConfig = `
+resource "turso_database" "test" {
+	organization_name = "jpedroh"
+	name	  = "tf-provider-resource"
+}`
+
+func TestDatabaseResourceUpdateSkipsUnchangedConfiguration(t *testing.T) {
+	config, _, faults := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + testDatabaseResourceConfig,
+			},
+			{
+				PreConfig: faults.Reset,
+				Config: config + `
+				resource "turso_database" "test" {
+					organization_name = "jpedroh"
+					name              = "tf-provider-resource"
+					wait_for_ready    = false
+				}`,
+				Check: func(*terraform.State) error {
+					if calls := faults.Calls(client.UpdateDatabaseConfigurationOperation); calls != 0 {
+						return fmt.Errorf("expected UpdateDatabaseConfiguration not to be called, got %d calls", calls)
+					}
+					return nil
+				},
+			},
+			{
+				Config: config + `
+				resource "turso_database" "test" {
+					organization_name = "jpedroh"
+					name              = "tf-provider-resource"
+					size_limit        = "256mb"
+				}`,
+				Check: func(*terraform.State) error {
+					if calls := faults.Calls(client.UpdateDatabaseConfigurationOperation); calls != 1 {
+						return fmt.Errorf("expected UpdateDatabaseConfiguration to be called once, got %d calls", calls)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestDatabaseResourceCreateConflict(t *testing.T) {
+	config, _, faults := newFaultyProviderConfig(t, 0)
+	faults.Fail(client.CreateDatabaseOperation, 1, http.StatusConflict)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config + testDatabaseResourceConfig,
+				ExpectError: regexp.MustCompile("Unable to create database, got error: Conflict"),
+			},
+		},
+	})
+}
+
+func TestDatabaseResourceCreateIsNotRetried(t *testing.T) {
+	config, _, faults := newFaultyProviderConfig(t, 3)
+	faults.Fail(client.CreateDatabaseOperation, 1, http.StatusServiceUnavailable)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config + testDatabaseResourceConfig,
+				ExpectError: regexp.MustCompile("Unable to create database"),
+			},
+		},
+	})
+
+	if calls := faults.Calls(client.CreateDatabaseOperation); calls != 1 {
+		t.Errorf("expected CreateDatabase to be called once, got %d", calls)
+	}
+}
+
+func TestDatabaseResourceCreateConfigurationError(t *testing.T) {
+	config, _, faults := newFaultyProviderConfig(t, 0)
+	faults.Fail(client.UpdateDatabaseConfigurationOperation, 1, http.StatusInternalServerError)
+
+	databaseConfig := config + `
+	resource "turso_database" "test" {
+		organization_name = "jpedroh"
+		name              = "tf-provider-resource"
+		block_writes      = true
+	}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      databaseConfig,
+				ExpectError: regexp.MustCompile("Database was created but its configuration could not be applied"),
+			},
+			{
+				// The tainted database is replaced and its settings are applied.
+				Config: databaseConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("turso_database.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("turso_database.test", "block_writes", "true"),
+					resource.TestCheckResourceAttr("turso_database.test", "delete_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatabaseResourceCreateTimeout(t *testing.T) {
+	config, _, faults := newFaultyProviderConfig(t, 0)
+	faults.Delay(client.CreateDatabaseOperation, 1, 10*time.Second)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				resource "turso_database" "test" {
+					organization_name = "jpedroh"
+					name	  = "tf-provider-resource"
+
+					timeouts {
+						create = "1s"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`context\s+deadline\s+exceeded`),
+			},
+		},
+	})
+}
+
+func TestDatabaseResourceRetriesRateLimitedReads(t *testing.T) {
+	config, _, faults := newFaultyProviderConfig(t, 3)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					faults.Inject(client.GetDatabaseOperation, 2, fakeapi.Fault{
+						StatusCode: http.StatusTooManyRequests,
+						Message:    "rate limited",
+						Header:     http.Header{"Retry-After": []string{"0"}},
+					})
+				},
+				Config: config + testDatabaseResourceConfig,
+				Check:  resource.TestCheckResourceAttrSet("turso_database.test", "db_id"),
+			},
+		},
+	})
+
+	if calls := faults.Calls(client.GetDatabaseOperation); calls < 3 {
+		t.Errorf("expected rate limited reads to be retried, got %d calls", calls)
+	}
+}
+
+func TestDatabaseResourceReadRemovesDeletedDatabase(t *testing.T) {
+	config, handler, _ := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + testDatabaseResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					_, err := handler.DeleteDatabase(context.Background(), client.DeleteDatabaseParams{
+						OrganizationSlug: "jpedroh",
+						DatabaseName:     "tf-provider-resource",
+					})
+					if err != nil {
+						t.Fatalf("unable to delete database: %s", err)
+					}
+				},
+				Config:             config + testDatabaseResourceConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestDatabaseResourceDeleteError(t *testing.T) {
+	config, _, faults := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + testDatabaseResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					faults.Fail(client.DeleteDatabaseOperation, 1, http.StatusInternalServerError)
+				},
+				Config:      config + testDatabaseResourceConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Unable to delete database"),
+			},
+		},
+	})
+}
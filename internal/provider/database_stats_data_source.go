@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-turso/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DatabaseStatsDataSource{}
+
+func NewDatabaseStatsDataSource() datasource.DataSource {
+	return &DatabaseStatsDataSource{}
+}
+
+// DatabaseStatsDataSource defines the data source implementation.
+type DatabaseStatsDataSource struct {
+	client *client.Client
+}
+
+// DatabaseStatsDataSourceModel describes the data source data model.
+type DatabaseStatsDataSourceModel struct {
+	OrganizationName types.String `tfsdk:"organization_name"`
+	DatabaseName     types.String `tfsdk:"database_name"`
+
+	// Computed
+	TopQueries []DatabaseQueryStatsModel `tfsdk:"top_queries"`
+}
+
+type DatabaseQueryStatsModel struct {
+	Query       types.String `tfsdk:"query"`
+	RowsRead    types.Int64  `tfsdk:"rows_read"`
+	RowsWritten types.Int64  `tfsdk:"rows_written"`
+}
+
+func (d *DatabaseStatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_stats"
+}
+
+func (d *DatabaseStatsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Database Stats data source. Reads the queries of a database that read or wrote the most rows.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization.",
+				Required:            true,
+			},
+			"database_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the database.",
+				Required:            true,
+			},
+			"top_queries": schema.ListNestedAttribute{
+				MarkdownDescription: "The top queries, in the order returned by the API. Empty if there are no stats yet.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"query": schema.StringAttribute{
+							MarkdownDescription: "The SQL query.",
+							Computed:            true,
+						},
+						"rows_read": schema.Int64Attribute{
+							MarkdownDescription: "The number of rows read by the query.",
+							Computed:            true,
+						},
+						"rows_written": schema.Int64Attribute{
+							MarkdownDescription: "The number of rows written by the query.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DatabaseStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DatabaseStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DatabaseStatsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.client.GetDatabaseStats(ctx, client.GetDatabaseStatsParams{
+		OrganizationSlug: data.OrganizationName.ValueString(),
+		DatabaseName:     data.DatabaseName.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database stats, got error: %s", err.Error()))
+		return
+	}
+
+	data.TopQueries = []DatabaseQueryStatsModel{}
+
+	switch p := res.(type) {
+	case *client.GetDatabaseStatsOK:
+		for _, query := range p.TopQueries.Value {
+			data.TopQueries = append(data.TopQueries, DatabaseQueryStatsModel{
+				Query:       types.StringValue(query.Query.Value),
+				RowsRead:    types.Int64Value(int64(query.RowsRead.Value)),
+				RowsWritten: types.Int64Value(int64(query.RowsWritten.Value)),
+			})
+		}
+	case *client.DatabaseNotFoundResponse:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database stats, got error: %s", p.Error.Value))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
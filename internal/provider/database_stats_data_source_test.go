@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"terraform-provider-turso/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatabaseStatsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				data "turso_database_stats" "test" {
+					organization_name = "jpedroh"
+					database_name     = "tfproviderdatasource"
+				}`,
+				Check: resource.TestCheckResourceAttrSet("data.turso_database_stats.test", "top_queries.#"),
+			},
+		},
+	})
+}
+
+func TestDatabaseStatsDataSourceTopQueries(t *testing.T) {
+	config, handler, _ := newFaultyProviderConfig(t, 0)
+
+	err := handler.SetDatabaseStats("jpedroh", "tfproviderdatasource", []client.DatabaseStatsOutput{
+		{Query: client.NewOptString("SELECT * FROM users"), RowsRead: client.NewOptInt(500), RowsWritten: client.NewOptInt(0)},
+		{Query: client.NewOptString("INSERT INTO users VALUES (?)"), RowsRead: client.NewOptInt(0), RowsWritten: client.NewOptInt(20)},
+	})
+	if err != nil {
+		t.Fatalf("unable to seed stats: %s", err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				data "turso_database_stats" "test" {
+					organization_name = "jpedroh"
+					database_name     = "tfproviderdatasource"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.turso_database_stats.test", "top_queries.#", "2"),
+					resource.TestCheckResourceAttr("data.turso_database_stats.test", "top_queries.0.query", "SELECT * FROM users"),
+					resource.TestCheckResourceAttr("data.turso_database_stats.test", "top_queries.0.rows_read", "500"),
+					resource.TestCheckResourceAttr("data.turso_database_stats.test", "top_queries.1.rows_written", "20"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-turso/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ ephemeral.EphemeralResource = &DatabaseTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &DatabaseTokenEphemeralResource{}
+
+// defaultEphemeralTokenExpiration is used when an ephemeral token does not set
+// an expiration, so that tokens minted on every run do not outlive it for long.
+const defaultEphemeralTokenExpiration = "1h"
+
+func NewDatabaseTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &DatabaseTokenEphemeralResource{}
+}
+
+// DatabaseTokenEphemeralResource defines the ephemeral resource implementation.
+type DatabaseTokenEphemeralResource struct {
+	client *client.Client
+}
+
+// DatabaseTokenEphemeralResourceModel describes the ephemeral resource data model.
+type DatabaseTokenEphemeralResourceModel struct {
+	OrganizationName types.String `tfsdk:"organization_name"`
+	DatabaseName     types.String `tfsdk:"database_name"`
+	Expiration       types.String `tfsdk:"expiration"`
+	Authorization    types.String `tfsdk:"authorization"`
+
+	// Computed
+	JWT       types.String `tfsdk:"jwt"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+func (r *DatabaseTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_token"
+}
+
+func (r *DatabaseTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Database Token ephemeral resource. Mints a database token on every run and never writes it to the plan or state, " +
+			"so it can be passed to write-only arguments, provider configurations or other ephemeral resources.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization or user.",
+				Required:            true,
+			},
+			"database_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the database.",
+				Required:            true,
+			},
+			"expiration": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Expiration time for the token (e.g., 2w1d30m), or `never`. Defaults to `%s`.", defaultEphemeralTokenExpiration),
+				Optional:            true,
+				Computed:            true,
+			},
+			"authorization": schema.StringAttribute{
+				MarkdownDescription: "Authorization level for the token (full-access or read-only). Defaults to `full-access`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(client.CreateDatabaseTokenAuthorizationFullAccess), string(client.CreateDatabaseTokenAuthorizationReadOnly)),
+				},
+			},
+			"jwt": schema.StringAttribute{
+				MarkdownDescription: "The generated authorization token (JWT).",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the token expires, as an RFC 3339 timestamp. Null if it never expires.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *DatabaseTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DatabaseTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data DatabaseTokenEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authorization := client.CreateDatabaseTokenAuthorizationFullAccess
+	if data.Authorization.ValueString() == string(client.CreateDatabaseTokenAuthorizationReadOnly) {
+		authorization = client.CreateDatabaseTokenAuthorizationReadOnly
+	}
+
+	expiration := defaultEphemeralTokenExpiration
+	if !data.Expiration.IsNull() {
+		expiration = data.Expiration.ValueString()
+	}
+
+	res, err := r.client.CreateDatabaseToken(ctx, client.OptCreateTokenInput{}, client.CreateDatabaseTokenParams{
+		OrganizationSlug: data.OrganizationName.ValueString(),
+		DatabaseName:     data.DatabaseName.ValueString(),
+		Expiration:       client.NewOptString(expiration),
+		Authorization:    client.NewOptCreateDatabaseTokenAuthorization(authorization),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create database token, got error: %s", err.Error()))
+		return
+	}
+
+	switch p := res.(type) {
+	case *client.CreateDatabaseTokenOK:
+		data.JWT = types.StringValue(p.Jwt.Value)
+		data.Authorization = types.StringValue(string(authorization))
+		data.Expiration = types.StringValue(expiration)
+		data.ExpiresAt = tokenExpiresAt(ctx, p.Jwt.Value)
+	case *client.CreateDatabaseTokenBadRequest:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create database token, got error: %s", p.Error.Value))
+		return
+	case *client.DatabaseNotFoundResponse:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create database token, got error: %s", p.Error.Value))
+		return
+	}
+
+	tflog.Trace(ctx, "opened ephemeral database token")
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// tokenExpiresAt returns when the token expires, or null if it never expires
+// or its claims cannot be decoded.
+func tokenExpiresAt(ctx context.Context, jwt string) types.String {
+	claims, err := DecodeTokenClaims(jwt)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Unable to decode token claims: %s", err.Error()))
+		return types.StringNull()
+	}
+
+	if claims.ExpiresAt == 0 {
+		return types.StringNull()
+	}
+
+	return types.StringValue(time.Unix(claims.ExpiresAt, 0).UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccDatabaseTokenEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				ephemeral "turso_database_token" "test" {
+					organization_name = "jpedroh"
+					database_name     = "tfproviderdatasource"
+					authorization     = "read-only"
+				}
+
+				provider "echo" {
+					data = ephemeral.turso_database_token.test
+				}
+
+				resource "echo" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.test", "data.authorization", "read-only"),
+					resource.TestCheckResourceAttr("echo.test", "data.expiration", "1h"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.jwt"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.expires_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatabaseTokenEphemeralResourceDatabaseNotFound(t *testing.T) {
+	config, _, _ := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				ephemeral "turso_database_token" "test" {
+					organization_name = "jpedroh"
+					database_name     = "missing"
+				}
+
+				provider "echo" {
+					data = ephemeral.turso_database_token.test
+				}
+
+				resource "echo" "test" {}`,
+				ExpectError: regexp.MustCompile(`Unable to create database token, got error: database missing not\s+found`),
+			},
+		},
+	})
+}
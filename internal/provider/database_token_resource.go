@@ -5,20 +5,56 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
 	"terraform-provider-turso/internal/client"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &DatabaseTokenResource{}
 var _ resource.ResourceWithImportState = &DatabaseTokenResource{}
+var _ resource.ResourceWithModifyPlan = &DatabaseTokenResource{}
+
+const defaultDatabaseTokenCreateTimeout = 5 * time.Minute
+
+// databaseTokenImportEnvVar supplies the JWT of a token imported with an
+// organization/database identifier.
+const databaseTokenImportEnvVar = "TURSO_IMPORT_DATABASE_TOKEN"
+
+const (
+	// tokenOutputFormatRaw writes the JWT alone to the output file.
+	tokenOutputFormatRaw = "raw"
+	// tokenOutputFormatEnv writes the JWT as a tokenOutputEnvVar assignment.
+	tokenOutputFormatEnv = "env"
+
+	tokenOutputEnvVar = "TURSO_AUTH_TOKEN"
+
+	defaultTokenOutputFilePermission = "0600"
+)
 
 func NewDatabaseTokenResource() resource.Resource {
 	return &DatabaseTokenResource{}
@@ -34,7 +70,15 @@ type DatabaseTokenResourceModel struct {
 	Expiration       types.String `tfsdk:"expiration"`
 	Authorization    types.String `tfsdk:"authorization"`
 
-	JWT types.String `tfsdk:"jwt"`
+	StoreJWTInState      types.Bool   `tfsdk:"store_jwt_in_state"`
+	OutputFile           types.String `tfsdk:"output_file"`
+	OutputFormat         types.String `tfsdk:"output_format"`
+	OutputFilePermission types.String `tfsdk:"output_file_permission"`
+
+	JWT       types.String `tfsdk:"jwt"`
+	JWTSHA256 types.String `tfsdk:"jwt_sha256"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *DatabaseTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -43,7 +87,9 @@ func (r *DatabaseTokenResource) Metadata(ctx context.Context, req resource.Metad
 
 func (r *DatabaseTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Database Token resource",
+		MarkdownDescription: "Database Token resource. An existing token can be imported with the identifier " +
+			"`organization/database/jwt`, or with `organization/database` and the JWT in the `" + databaseTokenImportEnvVar + "` environment variable. " +
+			"An imported token takes `store_jwt_in_state` and the output options from the configuration on the next apply without being replaced.",
 
 		Attributes: map[string]schema.Attribute{
 			"organization_name": schema.StringAttribute{
@@ -64,7 +110,11 @@ func (r *DatabaseTokenResource) Schema(ctx context.Context, req resource.SchemaR
 				MarkdownDescription: "Expiration time for the token (e.g., 2w1d30m).",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(
+						expirationChanged,
+						"Replaces the token if the expiration is a different duration.",
+						"Replaces the token if the expiration is a different duration.",
+					),
 				},
 			},
 			"authorization": schema.StringAttribute{
@@ -76,14 +126,80 @@ func (r *DatabaseTokenResource) Schema(ctx context.Context, req resource.SchemaR
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"store_jwt_in_state": schema.BoolAttribute{
+				MarkdownDescription: "Whether to store the JWT in `jwt`, and therefore in the state. Defaults to `true`. " +
+					"When `false`, use `output_file` to deliver the token and `jwt_sha256` to tell tokens apart.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplaceIf(
+						storeJWTInStateChanged,
+						"Replaces the token unless it was imported.",
+						"Replaces the token unless it was imported.",
+					),
+				},
+			},
+			"output_file": schema.StringAttribute{
+				MarkdownDescription: "A local file to write the JWT to. It is rewritten whenever the token is replaced, " +
+					"the token is replaced if the file is deleted, and the file is deleted with the token unless it holds another token by then.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						outputOptionChanged,
+						"Replaces the token unless it was imported.",
+						"Replaces the token unless it was imported.",
+					),
+				},
+			},
+			"output_format": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The format of `output_file`: `%s` for the JWT alone, or `%s` for a `%s=<jwt>` line. Defaults to `%s`.",
+					tokenOutputFormatRaw, tokenOutputFormatEnv, tokenOutputEnvVar, tokenOutputFormatRaw),
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(tokenOutputFormatRaw),
+				Validators: []validator.String{
+					stringvalidator.OneOf(tokenOutputFormatRaw, tokenOutputFormatEnv),
+					stringvalidator.AlsoRequires(path.MatchRoot("output_file")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						outputOptionChanged,
+						"Replaces the token unless it was imported.",
+						"Replaces the token unless it was imported.",
+					),
+				},
+			},
+			"output_file_permission": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The permissions of `output_file`, in octal. Defaults to `%s`.", defaultTokenOutputFilePermission),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultTokenOutputFilePermission),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^0?[0-7]{3}$`), "must be an octal file mode such as 0600"),
+					stringvalidator.AlsoRequires(path.MatchRoot("output_file")),
+				},
+			},
 			"jwt": schema.StringAttribute{
-				MarkdownDescription: "The generated authorization token (JWT).",
+				MarkdownDescription: "The generated authorization token (JWT). Null if `store_jwt_in_state` is `false`.",
 				Computed:            true,
 				Sensitive:           true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"jwt_sha256": schema.StringAttribute{
+				MarkdownDescription: "The hex encoded SHA-256 digest of the JWT, which changes whenever the token is replaced.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
 		},
 	}
 }
@@ -107,6 +223,32 @@ func (r *DatabaseTokenResource) Configure(ctx context.Context, req resource.Conf
 	r.client = client
 }
 
+func (r *DatabaseTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to adjust when the token is created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	imported, diags := importedToken(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+
+	if !imported || resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The imported JWT is kept in the state only if the configuration allows
+	// it, which Update applies.
+	var storeJWTInState types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("store_jwt_in_state"), &storeJWTInState)...)
+
+	switch {
+	case storeJWTInState.IsUnknown():
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("jwt"), types.StringUnknown())...)
+	case !storeJWTInState.ValueBool():
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("jwt"), types.StringNull())...)
+	}
+}
+
 func (r *DatabaseTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data DatabaseTokenResourceModel
 
@@ -117,6 +259,16 @@ func (r *DatabaseTokenResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultDatabaseTokenCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	authorization := client.CreateDatabaseTokenAuthorizationFullAccess
 	if data.Authorization.ValueString() == "read-only" {
 		authorization = client.CreateDatabaseTokenAuthorizationReadOnly
@@ -134,10 +286,26 @@ func (r *DatabaseTokenResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	var jwt string
+
 	switch p := res.(type) {
 	case *client.CreateDatabaseTokenOK:
-		data.JWT = types.StringValue(p.Jwt.Value)
+		jwt = p.Jwt.Value
+		data.setJWT(jwt)
 		data.Authorization = types.StringValue(string(authorization))
+	case *client.CreateDatabaseTokenBadRequest:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create database token, got error: %s", p.Error.Value))
+		return
+	case *client.DatabaseNotFoundResponse:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create database token, got error: %s", p.Error.Value))
+		return
+	}
+
+	if !data.OutputFile.IsNull() {
+		if err := writeTokenFile(data.OutputFile.ValueString(), data.OutputFormat.ValueString(), data.OutputFilePermission.ValueString(), jwt); err != nil {
+			resp.Diagnostics.AddError("Output File Error", fmt.Sprintf("Unable to write database token to %s, got error: %s", data.OutputFile.ValueString(), err.Error()))
+			return
+		}
 	}
 
 	// Write logs using the tflog package
@@ -160,6 +328,16 @@ func (r *DatabaseTokenResource) Read(ctx context.Context, req resource.ReadReque
 
 	// TODO: Currently, it's not possible to read a token
 
+	if !data.OutputFile.IsNull() {
+		if _, err := os.Stat(data.OutputFile.ValueString()); errors.Is(err, fs.ErrNotExist) {
+			// The JWT may not be in the state, so the file cannot be restored and
+			// a new token is created instead.
+			tflog.Warn(ctx, fmt.Sprintf("Database token output file %s not found, removing the token from state", data.OutputFile.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -176,6 +354,45 @@ func (r *DatabaseTokenResource) Update(ctx context.Context, req resource.UpdateR
 
 	// TODO: Currently, it's not possible to update a token
 
+	imported, diags := importedToken(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if imported {
+		// The output options were not set on import, so they are applied to the
+		// imported JWT now.
+		var jwt types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("jwt"), &jwt)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.setJWT(jwt.ValueString())
+
+		if !data.OutputFile.IsNull() {
+			if err := writeTokenFile(data.OutputFile.ValueString(), data.OutputFormat.ValueString(), data.OutputFilePermission.ValueString(), jwt.ValueString()); err != nil {
+				resp.Diagnostics.AddError("Output File Error", fmt.Sprintf("Unable to write database token to %s, got error: %s", data.OutputFile.ValueString(), err.Error()))
+				return
+			}
+		}
+	}
+
+	if !data.OutputFile.IsNull() {
+		mode, err := parseFileMode(data.OutputFilePermission.ValueString())
+		if err == nil {
+			err = os.Chmod(data.OutputFile.ValueString(), mode)
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError("Output File Error", fmt.Sprintf("Unable to change the permissions of %s, got error: %s", data.OutputFile.ValueString(), err.Error()))
+			return
+		}
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -191,8 +408,225 @@ func (r *DatabaseTokenResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	// TODO: Currently it's not possible to granularly revoke a token.
+
+	if data.OutputFile.IsNull() {
+		return
+	}
+
+	// A replacement created before this token was destroyed may already have
+	// written its own JWT to the same file, which is kept.
+	jwt, err := readTokenFile(data.OutputFile.ValueString())
+	if errors.Is(err, fs.ErrNotExist) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Output File Error", fmt.Sprintf("Unable to read %s, got error: %s", data.OutputFile.ValueString(), err.Error()))
+		return
+	}
+
+	if jwtSHA256(jwt) != data.JWTSHA256.ValueString() {
+		tflog.Debug(ctx, fmt.Sprintf("Database token output file %s holds another token, keeping it", data.OutputFile.ValueString()))
+		return
+	}
+
+	if err := os.Remove(data.OutputFile.ValueString()); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		resp.Diagnostics.AddError("Output File Error", fmt.Sprintf("Unable to delete %s, got error: %s", data.OutputFile.ValueString(), err.Error()))
+		return
+	}
 }
 
 func (r *DatabaseTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// TODO: Currently, it's not possible to import a token.
+	idParts := strings.Split(req.ID, "/")
+
+	if (len(idParts) != 2 && len(idParts) != 3) || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organization/database or organization/database/jwt. Got: %q", req.ID),
+		)
+		return
+	}
+
+	organization_name := idParts[0]
+	database_name := idParts[1]
+
+	// Tokens cannot be read back from the API, so the JWT has to be supplied.
+	jwt := os.Getenv(databaseTokenImportEnvVar)
+	if len(idParts) == 3 {
+		jwt = idParts[2]
+	}
+
+	if jwt == "" {
+		resp.Diagnostics.AddError(
+			"Missing Token",
+			fmt.Sprintf("Set the %s environment variable to the JWT of the token, or use an import identifier with format: organization/database/jwt.", databaseTokenImportEnvVar),
+		)
+		return
+	}
+
+	claims, err := DecodeTokenClaims(jwt)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Token", fmt.Sprintf("Unable to decode the imported token: %s", err.Error()))
+		return
+	}
+
+	res, err := r.client.GetDatabase(ctx, client.GetDatabaseParams{
+		OrganizationSlug: organization_name,
+		DatabaseName:     database_name,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database, got error: %s", err.Error()))
+		return
+	}
+
+	switch p := res.(type) {
+	case *client.GetDatabaseOK:
+		if claims.ID != "" && claims.ID != p.Database.Value.DbId.Value {
+			resp.Diagnostics.AddError(
+				"Invalid Token",
+				fmt.Sprintf("The imported token was not issued for database %s/%s.", organization_name, database_name),
+			)
+			return
+		}
+	case *client.DatabaseNotFoundResponse:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import database token, got error: %s", p.Error.Value))
+		return
+	}
+
+	if claims.ExpiresAt != 0 && time.Unix(claims.ExpiresAt, 0).Before(time.Now()) {
+		resp.Diagnostics.AddWarning(
+			"Expired Token",
+			fmt.Sprintf("The imported token expired at %s.", time.Unix(claims.ExpiresAt, 0).UTC().Format(time.RFC3339)),
+		)
+	}
+
+	expiration := types.StringNull()
+	if e := claims.Expiration(); e != "" {
+		expiration = types.StringValue(e)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing database token for %s/%s", organization_name, database_name))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_name"), organization_name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database_name"), database_name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("authorization"), claims.Authorization())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("expiration"), expiration)...)
+	// store_jwt_in_state and the output options are left unset, so that they
+	// are taken from the configuration without replacing the token.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("jwt"), jwt)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("jwt_sha256"), jwtSHA256(jwt))...)
+}
+
+// setJWT sets the JWT, unless it should not be stored in the state, and its
+// digest.
+func (data *DatabaseTokenResourceModel) setJWT(jwt string) {
+	data.JWT = types.StringNull()
+	if data.StoreJWTInState.IsNull() || data.StoreJWTInState.ValueBool() {
+		data.JWT = types.StringValue(jwt)
+	}
+
+	data.JWTSHA256 = types.StringValue(jwtSHA256(jwt))
+}
+
+func jwtSHA256(jwt string) string {
+	sum := sha256.Sum256([]byte(jwt))
+	return hex.EncodeToString(sum[:])
+}
+
+// writeTokenFile writes jwt to name in the given format, creating missing
+// parent directories, and sets the permissions of the file to permission.
+func writeTokenFile(name, format, permission, jwt string) error {
+	mode, err := parseFileMode(permission)
+	if err != nil {
+		return err
+	}
+
+	content := jwt + "\n"
+	if format == tokenOutputFormatEnv {
+		content = tokenOutputEnvVar + "=" + jwt + "\n"
+	}
+
+	if err := os.MkdirAll(filepath.Dir(name), 0o700); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(name, []byte(content), mode); err != nil {
+		return err
+	}
+
+	// WriteFile only applies the mode to new files.
+	return os.Chmod(name, mode)
+}
+
+// readTokenFile returns the JWT written to name in either output format.
+func readTokenFile(name string) (string, error) {
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+
+	jwt := strings.TrimSuffix(string(content), "\n")
+	jwt = strings.TrimPrefix(jwt, tokenOutputEnvVar+"=")
+
+	return jwt, nil
+}
+
+func parseFileMode(permission string) (fs.FileMode, error) {
+	mode, err := strconv.ParseUint(permission, 8, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid file mode", permission)
+	}
+
+	return fs.FileMode(mode), nil
+}
+
+// importedToken reports whether the token in state was imported and has not
+// been applied since, in which case store_jwt_in_state is not set.
+func importedToken(ctx context.Context, state tfsdk.State) (bool, diag.Diagnostics) {
+	if state.Raw.IsNull() {
+		return false, nil
+	}
+
+	var storeJWTInState types.Bool
+	diags := state.GetAttribute(ctx, path.Root("store_jwt_in_state"), &storeJWTInState)
+
+	return storeJWTInState.IsNull(), diags
+}
+
+// storeJWTInStateChanged requires a replacement unless the token was imported.
+func storeJWTInStateChanged(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+	imported, diags := importedToken(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	resp.RequiresReplace = !imported
+}
+
+// outputOptionChanged requires a replacement unless the token was imported, as
+// the imported JWT can still be written to the output file.
+func outputOptionChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	imported, diags := importedToken(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	resp.RequiresReplace = !imported
+}
+
+// expirationChanged requires a replacement unless the planned and current
+// expirations are the same duration, such as 1w and 7d. This keeps imported
+// tokens, whose expiration is derived from the JWT, from being replaced.
+func expirationChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = true
+
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	planned, err := ParseTokenExpiration(req.PlanValue.ValueString())
+	if err != nil {
+		return
+	}
+
+	current, err := ParseTokenExpiration(req.StateValue.ValueString())
+	if err != nil {
+		return
+	}
+
+	resp.RequiresReplace = planned != current
 }
@@ -4,9 +4,22 @@
 package provider
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"terraform-provider-turso/internal/client"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccDatabaseTokenResource(t *testing.T) {
@@ -43,3 +56,326 @@ func TestAccDatabaseTokenResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDatabaseTokenResourceImport(t *testing.T) {
+	jwt := func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources["turso_database_token.test"]
+		if !ok {
+			return "", fmt.Errorf("turso_database_token.test not found")
+		}
+		return rs.Primary.Attributes["jwt"], nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "turso_database_token" "test" {
+					organization_name = "jpedroh"
+					database_name     = "tfproviderdatasource"
+					expiration        = "1w"
+					authorization     = "read-only"
+				}`,
+			},
+			{
+				ResourceName: "turso_database_token.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					token, err := jwt(s)
+					return "jpedroh/tfproviderdatasource/" + token, err
+				},
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "jwt",
+				// These are taken from the configuration on the next apply.
+				ImportStateVerifyIgnore: []string{"store_jwt_in_state", "output_format", "output_file_permission"},
+			},
+			{
+				ResourceName: "turso_database_token.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					token, err := jwt(s)
+					t.Setenv(databaseTokenImportEnvVar, token)
+					return "jpedroh/tfproviderdatasource", err
+				},
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "jwt",
+				// These are taken from the configuration on the next apply.
+				ImportStateVerifyIgnore: []string{"store_jwt_in_state", "output_format", "output_file_permission"},
+			},
+			{
+				ResourceName:  "turso_database_token.test",
+				ImportState:   true,
+				ImportStateId: "jpedroh",
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+			{
+				ResourceName:  "turso_database_token.test",
+				ImportState:   true,
+				ImportStateId: "jpedroh/tfproviderdatasource/not-a-jwt",
+				ExpectError:   regexp.MustCompile(`the token is not a JWT`),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseTokenResourceOutputFile(t *testing.T) {
+	outputFile := filepath.Join(t.TempDir(), "secrets", "turso.env")
+
+	config := func(permission string) string {
+		return providerConfig + fmt.Sprintf(`
+		resource "turso_database_token" "test" {
+			organization_name      = "jpedroh"
+			database_name          = "tfproviderdatasource"
+			store_jwt_in_state     = false
+			output_file            = %q
+			output_format          = "env"
+			output_file_permission = %q
+		}`, outputFile, permission)
+	}
+
+	checkOutputFile := func(mode fs.FileMode) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			content, err := os.ReadFile(outputFile)
+			if err != nil {
+				return err
+			}
+
+			jwt, ok := strings.CutPrefix(strings.TrimSuffix(string(content), "\n"), "TURSO_AUTH_TOKEN=")
+			if !ok {
+				return fmt.Errorf("unexpected output file content %q", content)
+			}
+
+			sum := sha256.Sum256([]byte(jwt))
+			if err := resource.TestCheckResourceAttr("turso_database_token.test", "jwt_sha256", hex.EncodeToString(sum[:]))(s); err != nil {
+				return err
+			}
+
+			info, err := os.Stat(outputFile)
+			if err != nil {
+				return err
+			}
+
+			if info.Mode().Perm() != mode {
+				return fmt.Errorf("expected output file mode %v, got %v", mode, info.Mode().Perm())
+			}
+
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if _, err := os.Stat(outputFile); !errors.Is(err, fs.ErrNotExist) {
+				return fmt.Errorf("expected output file to be deleted, got: %v", err)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("0600"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("turso_database_token.test", "jwt"),
+					resource.TestCheckResourceAttrSet("turso_database_token.test", "jwt_sha256"),
+					checkOutputFile(0o600),
+				),
+			},
+			{
+				Config: config("0640"),
+				Check:  checkOutputFile(0o640),
+			},
+			{
+				// A deleted output file is restored with a new token.
+				PreConfig: func() {
+					if err := os.Remove(outputFile); err != nil {
+						t.Fatalf("unable to delete output file: %s", err)
+					}
+				},
+				Config: config("0640"),
+				Check:  checkOutputFile(0o640),
+			},
+		},
+	})
+}
+
+func TestDatabaseTokenResourceImportWithOutputOptions(t *testing.T) {
+	sourceConfig, _, _ := newFaultyProviderConfig(t, 0)
+	outputFile := filepath.Join(t.TempDir(), "turso.env")
+
+	sourceConfig += `
+	resource "turso_database_token" "source" {
+		organization_name = "jpedroh"
+		database_name     = "tfproviderdatasource"
+	}`
+
+	config := sourceConfig + fmt.Sprintf(`
+
+	resource "turso_database_token" "test" {
+		organization_name  = "jpedroh"
+		database_name      = "tfproviderdatasource"
+		store_jwt_in_state = false
+		output_file        = %q
+		output_format      = "env"
+	}`, outputFile)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: sourceConfig,
+			},
+			{
+				Config:       config,
+				ResourceName: "turso_database_token.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["turso_database_token.source"]
+					if !ok {
+						return "", fmt.Errorf("turso_database_token.source not found")
+					}
+					return "jpedroh/tfproviderdatasource/" + rs.Primary.Attributes["jwt"], nil
+				},
+				ImportStatePersist: true,
+			},
+			{
+				// The imported token takes the output options without being
+				// replaced.
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("turso_database_token.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("turso_database_token.test", "jwt"),
+					resource.TestCheckResourceAttrPair("turso_database_token.test", "jwt_sha256", "turso_database_token.source", "jwt_sha256"),
+					func(s *terraform.State) error {
+						content, err := os.ReadFile(outputFile)
+						if err != nil {
+							return err
+						}
+
+						expected := "TURSO_AUTH_TOKEN=" + s.RootModule().Resources["turso_database_token.source"].Primary.Attributes["jwt"] + "\n"
+						if string(content) != expected {
+							return fmt.Errorf("unexpected output file content %q", content)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestDatabaseTokenResourceCreateBeforeDestroyKeepsOutputFile(t *testing.T) {
+	config, _, _ := newFaultyProviderConfig(t, 0)
+	outputFile := filepath.Join(t.TempDir(), "turso.jwt")
+
+	tokenConfig := func(authorization string) string {
+		return config + fmt.Sprintf(`
+		resource "turso_database_token" "test" {
+			organization_name  = "jpedroh"
+			database_name      = "tfproviderdatasource"
+			authorization      = %q
+			store_jwt_in_state = false
+			output_file        = %q
+
+			lifecycle {
+				create_before_destroy = true
+			}
+		}`, authorization, outputFile)
+	}
+
+	checkOutputFile := func(s *terraform.State) error {
+		jwt, err := readTokenFile(outputFile)
+		if err != nil {
+			return err
+		}
+
+		return resource.TestCheckResourceAttr("turso_database_token.test", "jwt_sha256", jwtSHA256(jwt))(s)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: tokenConfig("full-access"),
+				Check:  checkOutputFile,
+			},
+			{
+				// The new token is written before the old one is destroyed, which
+				// must leave the new token in the file.
+				Config: tokenConfig("read-only"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("turso_database_token.test", plancheck.ResourceActionCreateBeforeDestroy),
+					},
+				},
+				Check: checkOutputFile,
+			},
+		},
+	})
+}
+
+func TestDatabaseTokenResourceOutputOptionsRequireOutputFile(t *testing.T) {
+	config, _, _ := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				resource "turso_database_token" "test" {
+					organization_name = "jpedroh"
+					database_name     = "tfproviderdatasource"
+					output_format     = "env"
+				}`,
+				ExpectError: regexp.MustCompile(`Attribute "output_file" must be specified`),
+			},
+		},
+	})
+}
+
+func TestDatabaseTokenResourceDatabaseNotFound(t *testing.T) {
+	config, _, _ := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				resource "turso_database_token" "test" {
+					organization_name = "jpedroh"
+					database_name	  = "missing"
+				}`,
+				ExpectError: regexp.MustCompile(`Unable to create database token, got error: database missing not\s+found`),
+			},
+		},
+	})
+}
+
+func TestDatabaseTokenResourceDoesNotRetryServerErrors(t *testing.T) {
+	config, _, faults := newFaultyProviderConfig(t, 3)
+	faults.Fail(client.CreateDatabaseTokenOperation, 1, http.StatusServiceUnavailable)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				resource "turso_database_token" "test" {
+					organization_name = "jpedroh"
+					database_name	  = "tfproviderdatasource"
+				}`,
+				ExpectError: regexp.MustCompile(`Unable to create database token`),
+			},
+		},
+	})
+
+	// A replayed mint could create a second token, so it is not retried.
+	if calls := faults.Calls(client.CreateDatabaseTokenOperation); calls != 1 {
+		t.Errorf("expected CreateDatabaseToken to be called once, got %d", calls)
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-turso/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &DatabaseUsageDataSource{}
+
+func NewDatabaseUsageDataSource() datasource.DataSource {
+	return &DatabaseUsageDataSource{}
+}
+
+// DatabaseUsageDataSource defines the data source implementation.
+type DatabaseUsageDataSource struct {
+	client *client.Client
+}
+
+// DatabaseUsageDataSourceModel describes the data source data model.
+type DatabaseUsageDataSourceModel struct {
+	OrganizationName types.String `tfsdk:"organization_name"`
+	DatabaseName     types.String `tfsdk:"database_name"`
+	From             types.String `tfsdk:"from"`
+	To               types.String `tfsdk:"to"`
+
+	// Computed
+	Total     *DatabaseUsageModel          `tfsdk:"total"`
+	Instances []DatabaseInstanceUsageModel `tfsdk:"instances"`
+}
+
+type DatabaseUsageModel struct {
+	RowsRead     types.Int64 `tfsdk:"rows_read"`
+	RowsWritten  types.Int64 `tfsdk:"rows_written"`
+	StorageBytes types.Int64 `tfsdk:"storage_bytes"`
+	BytesSynced  types.Int64 `tfsdk:"bytes_synced"`
+}
+
+type DatabaseInstanceUsageModel struct {
+	UUID         types.String `tfsdk:"uuid"`
+	RowsRead     types.Int64  `tfsdk:"rows_read"`
+	RowsWritten  types.Int64  `tfsdk:"rows_written"`
+	StorageBytes types.Int64  `tfsdk:"storage_bytes"`
+	BytesSynced  types.Int64  `tfsdk:"bytes_synced"`
+}
+
+func (d *DatabaseUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_usage"
+}
+
+func (d *DatabaseUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	usageAttributes := map[string]schema.Attribute{
+		"rows_read": schema.Int64Attribute{
+			MarkdownDescription: "The number of rows read.",
+			Computed:            true,
+		},
+		"rows_written": schema.Int64Attribute{
+			MarkdownDescription: "The number of rows written.",
+			Computed:            true,
+		},
+		"storage_bytes": schema.Int64Attribute{
+			MarkdownDescription: "The storage used in bytes.",
+			Computed:            true,
+		},
+		"bytes_synced": schema.Int64Attribute{
+			MarkdownDescription: "The number of bytes synced to embedded replicas.",
+			Computed:            true,
+		},
+	}
+
+	instanceAttributes := map[string]schema.Attribute{
+		"uuid": schema.StringAttribute{
+			MarkdownDescription: "The instance universal unique identifier (UUID).",
+			Computed:            true,
+		},
+	}
+	for name, attribute := range usageAttributes {
+		instanceAttributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Database Usage data source. Reads the usage of a database within a time window, in total and per instance.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization.",
+				Required:            true,
+			},
+			"database_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the database.",
+				Required:            true,
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "The start of the window as an RFC 3339 timestamp. Defaults to the start of the current billing cycle.",
+				Optional:            true,
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "The end of the window as an RFC 3339 timestamp. Defaults to now.",
+				Optional:            true,
+			},
+			"total": schema.SingleNestedAttribute{
+				MarkdownDescription: "The usage of all instances.",
+				Computed:            true,
+				Attributes:          usageAttributes,
+			},
+			"instances": schema.ListNestedAttribute{
+				MarkdownDescription: "The usage of every instance.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: instanceAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *DatabaseUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DatabaseUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DatabaseUsageDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := client.GetDatabaseUsageParams{
+		OrganizationSlug: data.OrganizationName.ValueString(),
+		DatabaseName:     data.DatabaseName.ValueString(),
+	}
+
+	for _, window := range []struct {
+		name  string
+		value types.String
+		param *client.OptDateTime
+	}{
+		{"from", data.From, &params.From},
+		{"to", data.To, &params.To},
+	} {
+		if window.value.IsNull() {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, window.value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(window.name),
+				"Invalid Timestamp",
+				fmt.Sprintf("Expected an RFC 3339 timestamp such as 2024-01-01T00:00:00Z, got: %q", window.value.ValueString()),
+			)
+			continue
+		}
+
+		*window.param = client.NewOptDateTime(t)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.client.GetDatabaseUsage(ctx, params)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database usage, got error: %s", err.Error()))
+		return
+	}
+
+	var usage client.DatabaseUsageOutput
+
+	switch p := res.(type) {
+	case *client.GetDatabaseUsageOK:
+		usage = p.Database.Value
+	case *client.GetDatabaseUsageBadRequest:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database usage, got error: %s", p.Error.Value))
+		return
+	case *client.DatabaseNotFoundResponse:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database usage, got error: %s", p.Error.Value))
+		return
+	}
+
+	total := usage.Total.Value
+	data.Total = &DatabaseUsageModel{
+		RowsRead:     types.Int64Value(int64(total.RowsRead.Value)),
+		RowsWritten:  types.Int64Value(int64(total.RowsWritten.Value)),
+		StorageBytes: types.Int64Value(int64(total.StorageBytes.Value)),
+		BytesSynced:  types.Int64Value(int64(total.BytesSynced.Value)),
+	}
+
+	data.Instances = []DatabaseInstanceUsageModel{}
+	for _, instance := range usage.Instances {
+		data.Instances = append(data.Instances, DatabaseInstanceUsageModel{
+			UUID:         types.StringValue(instance.UUID.Value),
+			RowsRead:     types.Int64Value(int64(instance.Usage.Value.RowsRead.Value)),
+			RowsWritten:  types.Int64Value(int64(instance.Usage.Value.RowsWritten.Value)),
+			StorageBytes: types.Int64Value(int64(instance.Usage.Value.StorageBytes.Value)),
+			BytesSynced:  types.Int64Value(int64(instance.Usage.Value.BytesSynced.Value)),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"terraform-provider-turso/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDatabaseUsageDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				data "turso_database_usage" "test" {
+					organization_name = "jpedroh"
+					database_name     = "tfproviderdatasource"
+					from              = "2024-01-01T00:00:00Z"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.turso_database_usage.test", "total.rows_read"),
+					resource.TestCheckResourceAttrSet("data.turso_database_usage.test", "instances.0.uuid"),
+				),
+			},
+		},
+	})
+}
+
+func TestDatabaseUsageDataSourceInstances(t *testing.T) {
+	config, handler, _ := newFaultyProviderConfig(t, 0)
+
+	err := handler.SetDatabaseUsage("jpedroh", "tfproviderdatasource", client.DatabaseUsageObject{
+		RowsRead:     client.NewOptInt(1200),
+		RowsWritten:  client.NewOptInt(34),
+		StorageBytes: client.NewOptInt(4096),
+		BytesSynced:  client.NewOptInt(0),
+	})
+	if err != nil {
+		t.Fatalf("unable to seed usage: %s", err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				data "turso_database_usage" "test" {
+					organization_name = "jpedroh"
+					database_name     = "tfproviderdatasource"
+					from              = "2024-01-01T00:00:00Z"
+					to                = "2023-01-01T00:00:00Z"
+				}`,
+				ExpectError: regexp.MustCompile(`Unable to read database usage, got error: from must be before to`),
+			},
+			{
+				Config: config + `
+				data "turso_database_usage" "test" {
+					organization_name = "jpedroh"
+					database_name     = "tfproviderdatasource"
+					from              = "yesterday"
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid Timestamp`),
+			},
+			{
+				Config: config + `
+				data "turso_database_usage" "test" {
+					organization_name = "jpedroh"
+					database_name     = "tfproviderdatasource"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.turso_database_usage.test", "total.rows_read", "1200"),
+					resource.TestCheckResourceAttr("data.turso_database_usage.test", "total.storage_bytes", "4096"),
+					resource.TestCheckResourceAttr("data.turso_database_usage.test", "instances.#", "1"),
+					resource.TestCheckResourceAttr("data.turso_database_usage.test", "instances.0.rows_written", "34"),
+				),
+			},
+		},
+	})
+}
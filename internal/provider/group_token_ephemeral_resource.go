@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-turso/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ ephemeral.EphemeralResource = &GroupTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &GroupTokenEphemeralResource{}
+
+func NewGroupTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &GroupTokenEphemeralResource{}
+}
+
+// GroupTokenEphemeralResource defines the ephemeral resource implementation.
+type GroupTokenEphemeralResource struct {
+	client *client.Client
+}
+
+// GroupTokenEphemeralResourceModel describes the ephemeral resource data model.
+type GroupTokenEphemeralResourceModel struct {
+	OrganizationName types.String `tfsdk:"organization_name"`
+	GroupName        types.String `tfsdk:"group_name"`
+	Expiration       types.String `tfsdk:"expiration"`
+	Authorization    types.String `tfsdk:"authorization"`
+
+	// Computed
+	JWT       types.String `tfsdk:"jwt"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+func (r *GroupTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_token"
+}
+
+func (r *GroupTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Group Token ephemeral resource. Mints a token for all databases of a group on every run and never writes it to the plan or state, " +
+			"so it can be passed to write-only arguments, provider configurations or other ephemeral resources.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization or user.",
+				Required:            true,
+			},
+			"group_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the group.",
+				Required:            true,
+			},
+			"expiration": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Expiration time for the token (e.g., 2w1d30m), or `never`. Defaults to `%s`.", defaultEphemeralTokenExpiration),
+				Optional:            true,
+				Computed:            true,
+			},
+			"authorization": schema.StringAttribute{
+				MarkdownDescription: "Authorization level for the token (full-access or read-only). Defaults to `full-access`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(client.CreateGroupTokenAuthorizationFullAccess), string(client.CreateGroupTokenAuthorizationReadOnly)),
+				},
+			},
+			"jwt": schema.StringAttribute{
+				MarkdownDescription: "The generated authorization token (JWT).",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the token expires, as an RFC 3339 timestamp. Null if it never expires.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *GroupTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GroupTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data GroupTokenEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authorization := client.CreateGroupTokenAuthorizationFullAccess
+	if data.Authorization.ValueString() == string(client.CreateGroupTokenAuthorizationReadOnly) {
+		authorization = client.CreateGroupTokenAuthorizationReadOnly
+	}
+
+	expiration := defaultEphemeralTokenExpiration
+	if !data.Expiration.IsNull() {
+		expiration = data.Expiration.ValueString()
+	}
+
+	res, err := r.client.CreateGroupToken(ctx, client.OptCreateTokenInput{}, client.CreateGroupTokenParams{
+		OrganizationSlug: data.OrganizationName.ValueString(),
+		GroupName:        data.GroupName.ValueString(),
+		Expiration:       client.NewOptString(expiration),
+		Authorization:    client.NewOptCreateGroupTokenAuthorization(authorization),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group token, got error: %s", err.Error()))
+		return
+	}
+
+	switch p := res.(type) {
+	case *client.CreateGroupTokenOK:
+		data.JWT = types.StringValue(p.Jwt.Value)
+		data.Authorization = types.StringValue(string(authorization))
+		data.Expiration = types.StringValue(expiration)
+		data.ExpiresAt = tokenExpiresAt(ctx, p.Jwt.Value)
+	case *client.CreateGroupTokenBadRequest:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group token, got error: %s", p.Error.Value))
+		return
+	case *client.GroupNotFoundResponse:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group token, got error: %s", p.Error.Value))
+		return
+	}
+
+	tflog.Trace(ctx, "opened ephemeral group token")
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
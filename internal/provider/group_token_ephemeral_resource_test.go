@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccGroupTokenEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				ephemeral "turso_group_token" "test" {
+					organization_name = "jpedroh"
+					group_name        = "default"
+					expiration        = "never"
+				}
+
+				provider "echo" {
+					data = ephemeral.turso_group_token.test
+				}
+
+				resource "echo" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.test", "data.authorization", "full-access"),
+					resource.TestCheckResourceAttr("echo.test", "data.expiration", "never"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.jwt"),
+					resource.TestCheckNoResourceAttr("echo.test", "data.expires_at"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &HttpUrlFunction{}
+
+func NewHttpUrlFunction() function.Function {
+	return &HttpUrlFunction{}
+}
+
+// HttpUrlFunction defines the function implementation.
+type HttpUrlFunction struct{}
+
+func (f *HttpUrlFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "http_url"
+}
+
+func (f *HttpUrlFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build an HTTP URL of a database",
+		MarkdownDescription: "Returns the `https://<hostname>` URL of a database, for clients that connect over HTTP.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "hostname",
+				MarkdownDescription: "The hostname of the database, such as the `hostname` of `turso_database`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *HttpUrlFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var hostname string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &hostname))
+
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validateHostname(hostname); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	u := url.URL{Scheme: "https", Host: hostname}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, u.String()))
+}
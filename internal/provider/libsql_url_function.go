@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &LibsqlUrlFunction{}
+
+func NewLibsqlUrlFunction() function.Function {
+	return &LibsqlUrlFunction{}
+}
+
+// LibsqlUrlFunction defines the function implementation.
+type LibsqlUrlFunction struct{}
+
+func (f *LibsqlUrlFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "libsql_url"
+}
+
+func (f *LibsqlUrlFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build a libSQL connection URL",
+		MarkdownDescription: "Returns a `libsql://<hostname>?authToken=<token>` URL to connect to a database with. The token is omitted if it is empty.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "hostname",
+				MarkdownDescription: "The hostname of the database, such as the `hostname` of `turso_database`.",
+			},
+			function.StringParameter{
+				Name:                "token",
+				MarkdownDescription: "The token to authenticate with, such as the `jwt` of `turso_database_token`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *LibsqlUrlFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var hostname, token string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &hostname, &token))
+
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validateHostname(hostname); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	u := url.URL{Scheme: "libsql", Host: hostname}
+	if token != "" {
+		u.RawQuery = url.Values{"authToken": {token}}.Encode()
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, u.String()))
+}
+
+// validateHostname reports an error if hostname is empty or is a URL rather
+// than a bare hostname.
+func validateHostname(hostname string) error {
+	if hostname == "" {
+		return fmt.Errorf("the hostname must not be empty")
+	}
+
+	if strings.ContainsAny(hostname, "/?#@ ") {
+		return fmt.Errorf("%q is not a hostname, expected a value such as db-org.aws-us-east-1.turso.io", hostname)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestLibsqlUrlFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::turso::libsql_url("db-org.aws-us-east-1.turso.io", "ey.J+/=")
+				}
+
+				output "without_token" {
+					value = provider::turso::libsql_url("db-org.aws-us-east-1.turso.io", "")
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "libsql://db-org.aws-us-east-1.turso.io?authToken=ey.J%2B%2F%3D"),
+					resource.TestCheckOutput("without_token", "libsql://db-org.aws-us-east-1.turso.io"),
+				),
+			},
+		},
+	})
+}
+
+func TestLibsqlUrlFunctionInvalidHostname(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::turso::libsql_url("libsql://db-org.aws-us-east-1.turso.io", "token")
+				}`,
+				ExpectError: regexp.MustCompile(`is not a hostname`),
+			},
+		},
+	})
+}
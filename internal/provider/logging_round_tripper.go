@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiLogSubsystem is the tflog subsystem used for Turso API requests. Its
+// level can be set independently with TF_LOG_PROVIDER_TURSO_API.
+const apiLogSubsystem = "api"
+
+const redactedValue = "***"
+
+// sensitiveBodyKeys are the JSON keys whose values are redacted from logged
+// request and response bodies.
+var sensitiveBodyKeys = map[string]bool{
+	"jwt":           true,
+	"token":         true,
+	"authorization": true,
+	"password":      true,
+}
+
+var (
+	bearerTokenRegexp = regexp.MustCompile(`(?i)bearer\s+[^\s"]+`)
+	jwtRegexp         = regexp.MustCompile(`eyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+)
+
+// LoggingRoundTripper writes every request and response through the tflog
+// "api" subsystem: method, path, status and latency at DEBUG, headers and
+// bodies at TRACE. Credentials are redacted before anything is logged.
+type LoggingRoundTripper struct {
+	Proxied http.RoundTripper
+}
+
+func (lrt LoggingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), apiLogSubsystem)
+	ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, apiLogSubsystem, bearerTokenRegexp, jwtRegexp)
+	ctx = tflog.SubsystemMaskMessageRegexes(ctx, apiLogSubsystem, bearerTokenRegexp, jwtRegexp)
+	ctx = tflog.SubsystemSetField(ctx, apiLogSubsystem, "http_method", req.Method)
+	ctx = tflog.SubsystemSetField(ctx, apiLogSubsystem, "http_path", req.URL.Path)
+
+	reqBody, err := peekRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.SubsystemDebug(ctx, apiLogSubsystem, "Sending API request")
+	tflog.SubsystemTrace(ctx, apiLogSubsystem, "API request details", map[string]interface{}{
+		"http_request_headers": redactHeaders(req.Header),
+		"http_request_body":    redactBody(reqBody),
+	})
+
+	start := time.Now()
+	res, err := lrt.Proxied.RoundTrip(req)
+	latency := time.Since(start)
+
+	if err != nil {
+		tflog.SubsystemDebug(ctx, apiLogSubsystem, "API request failed", map[string]interface{}{
+			"latency_ms": latency.Milliseconds(),
+			"error":      err.Error(),
+		})
+		return res, err
+	}
+
+	resBody, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.SubsystemDebug(ctx, apiLogSubsystem, "Received API response", map[string]interface{}{
+		"http_status": res.StatusCode,
+		"latency_ms":  latency.Milliseconds(),
+	})
+	tflog.SubsystemTrace(ctx, apiLogSubsystem, "API response details", map[string]interface{}{
+		"http_response_headers": redactHeaders(res.Header),
+		"http_response_body":    redactBody(resBody),
+	})
+
+	return res, nil
+}
+
+// peekRequestBody returns the request body without consuming it.
+func peekRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, err
+}
+
+// redactHeaders renders headers as a single string with credentials masked.
+func redactHeaders(header http.Header) string {
+	lines := make([]string, 0, len(header))
+
+	for name, values := range header {
+		value := strings.Join(values, ", ")
+		if strings.EqualFold(name, "Authorization") || strings.EqualFold(name, "Cookie") || strings.EqualFold(name, "Set-Cookie") {
+			value = redactedValue
+		}
+		lines = append(lines, name+": "+value)
+	}
+
+	sort.Strings(lines)
+
+	return strings.Join(lines, "\n")
+}
+
+// redactBody masks the values of sensitive keys in JSON bodies. Bodies that
+// are not JSON are returned with anything that looks like a token masked.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return jwtRegexp.ReplaceAllString(bearerTokenRegexp.ReplaceAllString(string(body), redactedValue), redactedValue)
+	}
+
+	redacted, err := json.Marshal(redactJSONValue(decoded))
+	if err != nil {
+		return redactedValue
+	}
+
+	return string(redacted)
+}
+
+func redactJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if sensitiveBodyKeys[strings.ToLower(key)] {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactJSONValue(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactJSONValue(item)
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-turso/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &OrganizationAuditLogsDataSource{}
+
+const (
+	// auditLogsPageSize is the number of entries requested per page.
+	auditLogsPageSize = 100
+	// defaultAuditLogsMaxResults is used when max_results is not set.
+	defaultAuditLogsMaxResults = 100
+)
+
+func NewOrganizationAuditLogsDataSource() datasource.DataSource {
+	return &OrganizationAuditLogsDataSource{}
+}
+
+// OrganizationAuditLogsDataSource defines the data source implementation.
+type OrganizationAuditLogsDataSource struct {
+	client *client.Client
+}
+
+// OrganizationAuditLogsDataSourceModel describes the data source data model.
+type OrganizationAuditLogsDataSourceModel struct {
+	OrganizationName types.String   `tfsdk:"organization_name"`
+	Codes            []types.String `tfsdk:"codes"`
+	Author           types.String   `tfsdk:"author"`
+	Since            types.String   `tfsdk:"since"`
+	MaxResults       types.Int64    `tfsdk:"max_results"`
+
+	// Computed
+	AuditLogs []AuditLogModel `tfsdk:"audit_logs"`
+}
+
+type AuditLogModel struct {
+	Code      types.String `tfsdk:"code"`
+	Message   types.String `tfsdk:"message"`
+	Origin    types.String `tfsdk:"origin"`
+	Author    types.String `tfsdk:"author"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Data      types.String `tfsdk:"data"`
+}
+
+func (d *OrganizationAuditLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_audit_logs"
+}
+
+func (d *OrganizationAuditLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	var codes []string
+	for _, code := range client.AuditLogCode("").AllValues() {
+		codes = append(codes, string(code))
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Organization Audit Logs data source. Lists the audit log entries of an organization, newest first, " +
+			"walking as many pages as needed to collect `max_results` matching entries.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization.",
+				Required:            true,
+			},
+			"codes": schema.SetAttribute{
+				MarkdownDescription: "Only list entries with one of these codes, such as `db-create` or `org-member-add`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(codes...)),
+				},
+			},
+			"author": schema.StringAttribute{
+				MarkdownDescription: "Only list entries of this author.",
+				Optional:            true,
+			},
+			"since": schema.StringAttribute{
+				MarkdownDescription: "Only list entries created at or after this RFC 3339 timestamp.",
+				Optional:            true,
+			},
+			"max_results": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of entries to list. Defaults to %d.", defaultAuditLogsMaxResults),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"audit_logs": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching entries, newest first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							MarkdownDescription: "The code of the action performed.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "A description of the action performed.",
+							Computed:            true,
+						},
+						"origin": schema.StringAttribute{
+							MarkdownDescription: "Where the action was performed from, such as `cli` or `web`.",
+							Computed:            true,
+						},
+						"author": schema.StringAttribute{
+							MarkdownDescription: "The username of the user who performed the action.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the action was performed.",
+							Computed:            true,
+						},
+						"data": schema.StringAttribute{
+							MarkdownDescription: "The payload of the action as JSON, which can be decoded with `jsondecode`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrganizationAuditLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationAuditLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationAuditLogsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var since time.Time
+	if !data.Since.IsNull() {
+		var err error
+		since, err = time.Parse(time.RFC3339, data.Since.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("since"),
+				"Invalid Timestamp",
+				fmt.Sprintf("Expected an RFC 3339 timestamp such as 2024-01-01T00:00:00Z, got: %q", data.Since.ValueString()),
+			)
+			return
+		}
+	}
+
+	codes := map[string]bool{}
+	for _, code := range data.Codes {
+		codes[code.ValueString()] = true
+	}
+
+	maxResults := defaultAuditLogsMaxResults
+	if !data.MaxResults.IsNull() {
+		maxResults = int(data.MaxResults.ValueInt64())
+	}
+
+	data.AuditLogs = []AuditLogModel{}
+
+pages:
+	for page := 1; ; page++ {
+		res, err := d.client.ListOrganizationAuditLogs(ctx, client.ListOrganizationAuditLogsParams{
+			OrganizationSlug: data.OrganizationName.ValueString(),
+			PageSize:         client.NewOptInt(auditLogsPageSize),
+			Page:             client.NewOptInt(page),
+		})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list organization audit logs, got error: %s", err.Error()))
+			return
+		}
+
+		for _, auditLog := range res.AuditLogs {
+			if !since.IsZero() {
+				createdAt, err := time.Parse(time.RFC3339, auditLog.CreatedAt.Value)
+
+				// Entries are listed newest first, so the rest are older too.
+				if err == nil && createdAt.Before(since) {
+					break pages
+				}
+			}
+
+			if len(codes) > 0 && !codes[string(auditLog.Code.Value)] {
+				continue
+			}
+
+			if !data.Author.IsNull() && auditLog.Author.Value != data.Author.ValueString() {
+				continue
+			}
+
+			model, err := newAuditLogModel(auditLog)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to encode audit log data, got error: %s", err.Error()))
+				return
+			}
+
+			data.AuditLogs = append(data.AuditLogs, model)
+
+			if len(data.AuditLogs) >= maxResults {
+				break pages
+			}
+		}
+
+		if len(res.AuditLogs) == 0 || page >= res.Pagination.Value.TotalPages.Value {
+			break
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func newAuditLogModel(auditLog client.AuditLog) (AuditLogModel, error) {
+	model := AuditLogModel{
+		Code:      types.StringValue(string(auditLog.Code.Value)),
+		Message:   types.StringValue(auditLog.Message.Value),
+		Origin:    types.StringValue(auditLog.Origin.Value),
+		Author:    types.StringValue(auditLog.Author.Value),
+		CreatedAt: types.StringValue(auditLog.CreatedAt.Value),
+		Data:      types.StringNull(),
+	}
+
+	if !auditLog.Data.Set {
+		return model, nil
+	}
+
+	raw, err := auditLog.Data.Value.MarshalJSON()
+	if err != nil {
+		return model, err
+	}
+
+	// Round trip the payload so that its keys are sorted and the JSON is
+	// stable across reads.
+	var payload interface{}
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return model, err
+	}
+
+	normalized, err := json.Marshal(payload)
+	if err != nil {
+		return model, err
+	}
+
+	model.Data = types.StringValue(string(normalized))
+
+	return model, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-turso/internal/client"
+	"testing"
+	"time"
+
+	"github.com/go-faster/jx"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationAuditLogsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				data "turso_organization_audit_logs" "test" {
+					organization_name = "jpedroh"
+					codes             = ["db-create"]
+					max_results       = 5
+				}`,
+				Check: resource.TestCheckResourceAttrSet("data.turso_organization_audit_logs.test", "audit_logs.#"),
+			},
+		},
+	})
+}
+
+func TestOrganizationAuditLogsDataSourcePagination(t *testing.T) {
+	config, handler, _ := newFaultyProviderConfig(t, 0)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 250; i++ {
+		code, author := client.AuditLogCodeDbCreate, "bob"
+		if i%2 == 1 {
+			code = client.AuditLogCodeOrgMemberAdd
+		}
+		if i%3 == 0 {
+			author = "alice"
+		}
+
+		err := handler.AddAuditLog("jpedroh", client.AuditLog{
+			Code:      client.NewOptAuditLogCode(code),
+			Message:   client.NewOptString(fmt.Sprintf("entry %d", i)),
+			Origin:    client.NewOptString("cli"),
+			Author:    client.NewOptString(author),
+			CreatedAt: client.NewOptString(start.Add(time.Duration(i) * time.Minute).Format(time.RFC3339)),
+			Data: client.NewOptAuditLogData(client.AuditLogData{
+				"name":  jx.Raw(fmt.Sprintf("%q", fmt.Sprintf("db-%d", i))),
+				"group": jx.Raw(`"default"`),
+			}),
+		})
+		if err != nil {
+			t.Fatalf("unable to seed audit log: %s", err)
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				data "turso_organization_audit_logs" "test" {
+					organization_name = "jpedroh"
+					since             = "last week"
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid Timestamp`),
+			},
+			{
+				// Matching entries are spread over three pages.
+				Config: config + `
+				data "turso_organization_audit_logs" "test" {
+					organization_name = "jpedroh"
+					codes             = ["db-create"]
+					max_results       = 120
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.turso_organization_audit_logs.test", "audit_logs.#", "120"),
+					resource.TestCheckResourceAttr("data.turso_organization_audit_logs.test", "audit_logs.0.message", "entry 248"),
+					resource.TestCheckResourceAttr("data.turso_organization_audit_logs.test", "audit_logs.0.data", `{"group":"default","name":"db-248"}`),
+					resource.TestCheckResourceAttr("data.turso_organization_audit_logs.test", "audit_logs.119.message", "entry 10"),
+				),
+			},
+			{
+				Config: config + `
+				data "turso_organization_audit_logs" "test" {
+					organization_name = "jpedroh"
+					author            = "alice"
+					since             = "2024-01-01T04:00:00Z"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.turso_organization_audit_logs.test", "audit_logs.#", "4"),
+					resource.TestCheckResourceAttr("data.turso_organization_audit_logs.test", "audit_logs.0.message", "entry 249"),
+					resource.TestCheckResourceAttr("data.turso_organization_audit_logs.test", "audit_logs.3.message", "entry 240"),
+					resource.TestCheckResourceAttr("data.turso_organization_audit_logs.test", "audit_logs.3.created_at", "2024-01-01T04:00:00Z"),
+				),
+			},
+		},
+	})
+}
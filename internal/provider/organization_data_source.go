@@ -29,8 +29,14 @@ type OrganizationDataSourceModel struct {
 	Slug types.String `tfsdk:"slug"`
 
 	// Computed
-	Name types.String `tfsdk:"name"`
-	Type types.String `tfsdk:"type"`
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+	Overages      types.Bool   `tfsdk:"overages"`
+	BlockedReads  types.Bool   `tfsdk:"blocked_reads"`
+	BlockedWrites types.Bool   `tfsdk:"blocked_writes"`
+	PlanId        types.String `tfsdk:"plan_id"`
+	PlanTimeline  types.String `tfsdk:"plan_timeline"`
+	Platform      types.String `tfsdk:"platform"`
 }
 
 func (d *OrganizationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -54,6 +60,30 @@ func (d *OrganizationDataSource) Schema(ctx context.Context, req datasource.Sche
 				MarkdownDescription: "The type of account this organization is. Will always be `personal` or `team`.",
 				Computed:            true,
 			},
+			"overages": schema.BoolAttribute{
+				MarkdownDescription: "Whether usage beyond the plan quotas is billed as overages.",
+				Computed:            true,
+			},
+			"blocked_reads": schema.BoolAttribute{
+				MarkdownDescription: "Whether reads are blocked for the organization.",
+				Computed:            true,
+			},
+			"blocked_writes": schema.BoolAttribute{
+				MarkdownDescription: "Whether writes are blocked for the organization.",
+				Computed:            true,
+			},
+			"plan_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the plan the organization is subscribed to.",
+				Computed:            true,
+			},
+			"plan_timeline": schema.StringAttribute{
+				MarkdownDescription: "The billing cycle of the plan, such as `monthly`.",
+				Computed:            true,
+			},
+			"platform": schema.StringAttribute{
+				MarkdownDescription: "The platform the organization is billed through, if any, such as `vercel`.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -99,9 +129,19 @@ func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	switch p := res.(type) {
 	case *client.GetOrganizationOK:
-		data.Name = types.StringValue(p.Organization.Value.Name.Value)
-		data.Type = types.StringValue(string(p.Organization.Value.Type.Value))
-		data.Slug = types.StringValue(p.Organization.Value.Slug.Value)
+		organization := p.Organization.Value
+		data.Name = types.StringValue(organization.Name.Value)
+		data.Type = types.StringValue(string(organization.Type.Value))
+		data.Slug = types.StringValue(organization.Slug.Value)
+		data.Overages = types.BoolValue(organization.Overages.Value)
+		data.BlockedReads = types.BoolValue(organization.BlockedReads.Value)
+		data.BlockedWrites = types.BoolValue(organization.BlockedWrites.Value)
+		data.PlanId = types.StringValue(organization.PlanID.Value)
+		data.PlanTimeline = types.StringValue(organization.PlanTimeline.Value)
+		data.Platform = types.StringValue(organization.Platform.Value)
+	case *client.GetOrganizationNotFound:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization, got error: %s", p.Error.Value))
+		return
 	}
 
 	// Save data into Terraform state
@@ -19,6 +19,11 @@ func TestAccOrganizationDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.turso_organization.test", "slug", "jpedroh"),
 					resource.TestCheckResourceAttr("data.turso_organization.test", "name", "jpedroh"),
 					resource.TestCheckResourceAttr("data.turso_organization.test", "type", "personal"),
+					resource.TestCheckResourceAttrSet("data.turso_organization.test", "overages"),
+					resource.TestCheckResourceAttr("data.turso_organization.test", "blocked_reads", "false"),
+					resource.TestCheckResourceAttr("data.turso_organization.test", "blocked_writes", "false"),
+					resource.TestCheckResourceAttrSet("data.turso_organization.test", "plan_id"),
+					resource.TestCheckResourceAttrSet("data.turso_organization.test", "plan_timeline"),
 				),
 			},
 		},
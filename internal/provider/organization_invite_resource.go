@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-turso/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &OrganizationInviteResource{}
+var _ resource.ResourceWithImportState = &OrganizationInviteResource{}
+
+func NewOrganizationInviteResource() resource.Resource {
+	return &OrganizationInviteResource{}
+}
+
+type OrganizationInviteResource struct {
+	client *client.Client
+}
+
+type OrganizationInviteResourceModel struct {
+	OrganizationName types.String `tfsdk:"organization_name"`
+	Email            types.String `tfsdk:"email"`
+	Role             types.String `tfsdk:"role"`
+	Accepted         types.Bool   `tfsdk:"accepted"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+}
+
+func (r *OrganizationInviteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_invite"
+}
+
+func (r *OrganizationInviteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Organization Invite resource. Invites a person who may not have a Turso account yet to an organization by email. " +
+			"Once the invite is accepted it is kept in state with `accepted` set; destroying it then has no effect on the new member, " +
+			"which can be managed with `turso_organization_member`.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address to invite.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The role given to the member once the invite is accepted (admin, member or viewer). Changing it sends a new invite.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("admin", "member", "viewer"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"accepted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the invite has been accepted.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "When the invite was sent.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationInviteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationInviteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationInviteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.InviteOrganizationMember(ctx, &client.InviteOrganizationMemberReq{
+		Email: data.Email.ValueString(),
+		Role:  client.NewOptInviteOrganizationMemberReqRole(client.InviteOrganizationMemberReqRole(data.Role.ValueString())),
+	}, client.InviteOrganizationMemberParams{
+		OrganizationSlug: data.OrganizationName.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to invite organization member, got error: %s", err.Error()))
+		return
+	}
+
+	data.Accepted = types.BoolValue(res.Invited.Value.Accepted.Value)
+	data.CreatedAt = types.StringValue(res.Invited.Value.CreatedAt.Value)
+
+	tflog.Trace(ctx, "created organization invite resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInviteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationInviteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invite, member, err := r.findInvite(ctx, data.OrganizationName.ValueString(), data.Email.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization invite, got error: %s", err.Error()))
+		return
+	}
+
+	switch {
+	case invite != nil:
+		data.Role = types.StringValue(string(invite.Role.Value))
+		data.Accepted = types.BoolValue(invite.Accepted.Value)
+		data.CreatedAt = types.StringValue(invite.CreatedAt.Value)
+	case member != nil:
+		// Accepted invites may be cleaned up by the API, possibly before a
+		// refresh saw them accepted. The invitee is a member now, so the invite
+		// is kept rather than sent again.
+		tflog.Debug(ctx, fmt.Sprintf("Accepted invite for %s is no longer listed", data.Email.ValueString()))
+		data.Accepted = types.BoolValue(true)
+		if data.Role.IsNull() {
+			data.Role = types.StringValue(string(member.Role.Value))
+		}
+	default:
+		// The pending invite was revoked outside of Terraform.
+		tflog.Warn(ctx, fmt.Sprintf("Invite for %s not found, removing it from state", data.Email.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInviteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrganizationInviteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute requires replacement, so there is nothing to update.
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInviteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrganizationInviteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Accepted.ValueBool() {
+		tflog.Warn(ctx, fmt.Sprintf("Invite for %s was already accepted, so there is nothing to revoke", data.Email.ValueString()))
+		return
+	}
+
+	res, err := r.client.DeleteOrganizationInviteByEmail(ctx, client.DeleteOrganizationInviteByEmailParams{
+		OrganizationSlug: data.OrganizationName.ValueString(),
+		Email:            data.Email.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke organization invite, got error: %s", err.Error()))
+		return
+	}
+
+	if _, ok := res.(*client.DeleteOrganizationInviteByEmailNotFound); ok {
+		tflog.Warn(ctx, fmt.Sprintf("Invite for %s was already revoked or accepted", data.Email.ValueString()))
+	}
+}
+
+func (r *OrganizationInviteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organization/email. Got: %q", req.ID),
+		)
+		return
+	}
+
+	invite, member, err := r.findInvite(ctx, idParts[0], idParts[1])
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization invite, got error: %s", err.Error()))
+		return
+	}
+
+	if invite == nil && member == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import organization invite, got error: no invite or member found for %s", idParts[1]))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing organization invite %s/%s", idParts[0], idParts[1]))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("accepted"), invite == nil || invite.Accepted.Value)...)
+}
+
+// findInvite returns the invite sent to email. If it is no longer listed, the
+// member with that email, if any, is returned instead, which means the invite
+// was accepted.
+func (r *OrganizationInviteResource) findInvite(ctx context.Context, organizationSlug, email string) (*client.Invite, *client.Member, error) {
+	invites, err := r.client.ListOrganizationInvites(ctx, client.ListOrganizationInvitesParams{
+		OrganizationSlug: organizationSlug,
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i := range invites.Invites {
+		if strings.EqualFold(invites.Invites[i].Email.Value, email) {
+			return &invites.Invites[i], nil, nil
+		}
+	}
+
+	members, err := r.client.ListOrganizationMembers(ctx, client.ListOrganizationMembersParams{
+		OrganizationSlug: organizationSlug,
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i := range members.Members {
+		if strings.EqualFold(members.Members[i].Email.Value, email) {
+			return nil, &members.Members[i], nil
+		}
+	}
+
+	return nil, nil, nil
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"terraform-provider-turso/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+const testOrganizationInviteResourceConfig = `
+resource "turso_organization_invite" "test" {
+	organization_name = "jpedroh"
+	email		  = "tfprovider-invite@example.com"
+	role		  = "member"
+}`
+
+func TestAccOrganizationInviteResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testOrganizationInviteResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("turso_organization_invite.test", "organization_name", "jpedroh"),
+					resource.TestCheckResourceAttr("turso_organization_invite.test", "email", "tfprovider-invite@example.com"),
+					resource.TestCheckResourceAttr("turso_organization_invite.test", "role", "member"),
+					resource.TestCheckResourceAttr("turso_organization_invite.test", "accepted", "false"),
+					resource.TestCheckResourceAttrSet("turso_organization_invite.test", "created_at"),
+				),
+			},
+			{
+				ResourceName:                         "turso_organization_invite.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "jpedroh/tfprovider-invite@example.com",
+				ImportStateVerifyIdentifierAttribute: "email",
+			},
+		},
+	})
+}
+
+func TestOrganizationInviteResourceAccepted(t *testing.T) {
+	config, handler, _ := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + testOrganizationInviteResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					if err := handler.AcceptInvite("jpedroh", "tfprovider-invite@example.com", "tfproviderinvitee"); err != nil {
+						t.Fatalf("unable to accept invite: %s", err)
+					}
+				},
+				Config: config + testOrganizationInviteResourceConfig,
+				Check:  resource.TestCheckResourceAttr("turso_organization_invite.test", "accepted", "true"),
+			},
+		},
+	})
+}
+
+func TestOrganizationInviteResourceAcceptedAndCleanedUp(t *testing.T) {
+	config, handler, _ := newFaultyProviderConfig(t, 0)
+
+	acceptAndCleanUp := func() {
+		if err := handler.AcceptInvite("jpedroh", "tfprovider-invite@example.com", "tfproviderinvitee"); err != nil {
+			t.Fatalf("unable to accept invite: %s", err)
+		}
+		if err := handler.RemoveInvite("jpedroh", "tfprovider-invite@example.com"); err != nil {
+			t.Fatalf("unable to remove invite: %s", err)
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + testOrganizationInviteResourceConfig,
+			},
+			{
+				// The invite is accepted and cleaned up before any refresh saw it
+				// accepted, so it must not be sent again.
+				PreConfig: acceptAndCleanUp,
+				Config:    config + testOrganizationInviteResourceConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("turso_organization_invite.test", "accepted", "true"),
+			},
+			{
+				ResourceName:                         "turso_organization_invite.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "jpedroh/tfprovider-invite@example.com",
+				ImportStateVerifyIdentifierAttribute: "email",
+				ImportStateVerifyIgnore:              []string{"created_at"},
+			},
+		},
+	})
+}
+
+func TestOrganizationInviteResourceReadRemovesRevokedInvite(t *testing.T) {
+	config, handler, _ := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + testOrganizationInviteResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					_, err := handler.DeleteOrganizationInviteByEmail(context.Background(), client.DeleteOrganizationInviteByEmailParams{
+						OrganizationSlug: "jpedroh",
+						Email:            "tfprovider-invite@example.com",
+					})
+					if err != nil {
+						t.Fatalf("unable to revoke invite: %s", err)
+					}
+				},
+				Config:             config + testOrganizationInviteResourceConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestOrganizationInviteResourceAlreadyInvited(t *testing.T) {
+	config, _, _ := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + testOrganizationInviteResourceConfig + `
+				resource "turso_organization_invite" "duplicate" {
+					organization_name = "jpedroh"
+					email		  = "tfprovider-invite@example.com"
+					role		  = "admin"
+
+					depends_on = [turso_organization_invite.test]
+				}`,
+				ExpectError: regexp.MustCompile(`Unable to invite organization member`),
+			},
+		},
+	})
+}
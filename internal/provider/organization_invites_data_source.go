@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"terraform-provider-turso/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &OrganizationInvitesDataSource{}
+
+func NewOrganizationInvitesDataSource() datasource.DataSource {
+	return &OrganizationInvitesDataSource{}
+}
+
+// OrganizationInvitesDataSource defines the data source implementation.
+type OrganizationInvitesDataSource struct {
+	client *client.Client
+}
+
+// OrganizationInvitesDataSourceModel describes the data source data model.
+type OrganizationInvitesDataSourceModel struct {
+	OrganizationName types.String `tfsdk:"organization_name"`
+
+	// Computed
+	Invites []OrganizationInviteModel `tfsdk:"invites"`
+}
+
+type OrganizationInviteModel struct {
+	Email     types.String `tfsdk:"email"`
+	Role      types.String `tfsdk:"role"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Accepted  types.Bool   `tfsdk:"accepted"`
+}
+
+func (d *OrganizationInvitesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_invites"
+}
+
+func (d *OrganizationInvitesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Organization Invites data source. Lists the email invites sent for an organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization.",
+				Required:            true,
+			},
+			"invites": schema.ListNestedAttribute{
+				MarkdownDescription: "The invites of the organization, sorted by email.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The invited email address.",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "The role given to the member once the invite is accepted (admin, member or viewer).",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the invite was sent.",
+							Computed:            true,
+						},
+						"accepted": schema.BoolAttribute{
+							MarkdownDescription: "Whether the invite has been accepted.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrganizationInvitesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationInvitesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationInvitesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.client.ListOrganizationInvites(ctx, client.ListOrganizationInvitesParams{
+		OrganizationSlug: data.OrganizationName.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list organization invites, got error: %s", err.Error()))
+		return
+	}
+
+	data.Invites = []OrganizationInviteModel{}
+	for _, invite := range res.Invites {
+		data.Invites = append(data.Invites, OrganizationInviteModel{
+			Email:     types.StringValue(invite.Email.Value),
+			Role:      types.StringValue(string(invite.Role.Value)),
+			CreatedAt: types.StringValue(invite.CreatedAt.Value),
+			Accepted:  types.BoolValue(invite.Accepted.Value),
+		})
+	}
+
+	sort.Slice(data.Invites, func(i, j int) bool {
+		return data.Invites[i].Email.ValueString() < data.Invites[j].Email.ValueString()
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationInvitesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "turso_organization_invite" "test" {
+					organization_name = "jpedroh"
+					email		  = "tfprovider-invites@example.com"
+					role		  = "viewer"
+				}
+
+				data "turso_organization_invites" "test" {
+					organization_name = turso_organization_invite.test.organization_name
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.turso_organization_invites.test", "organization_name", "jpedroh"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.turso_organization_invites.test", "invites.*", map[string]string{
+						"email":    "tfprovider-invites@example.com",
+						"role":     "viewer",
+						"accepted": "false",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("data.turso_organization_invites.test", "invites.*.created_at", "turso_organization_invite.test", "created_at"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-turso/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &OrganizationInvoicesDataSource{}
+
+func NewOrganizationInvoicesDataSource() datasource.DataSource {
+	return &OrganizationInvoicesDataSource{}
+}
+
+// OrganizationInvoicesDataSource defines the data source implementation.
+type OrganizationInvoicesDataSource struct {
+	client *client.Client
+}
+
+// OrganizationInvoicesDataSourceModel describes the data source data model.
+type OrganizationInvoicesDataSourceModel struct {
+	OrganizationName types.String `tfsdk:"organization_name"`
+	Type             types.String `tfsdk:"type"`
+
+	// Computed
+	Invoices       []OrganizationInvoiceModel `tfsdk:"invoices"`
+	TotalAmountDue types.Float64              `tfsdk:"total_amount_due"`
+}
+
+type OrganizationInvoiceModel struct {
+	InvoiceNumber   types.String  `tfsdk:"invoice_number"`
+	AmountDue       types.Float64 `tfsdk:"amount_due"`
+	DueDate         types.String  `tfsdk:"due_date"`
+	PaidAt          types.String  `tfsdk:"paid_at"`
+	PaymentFailedAt types.String  `tfsdk:"payment_failed_at"`
+	InvoicePdf      types.String  `tfsdk:"invoice_pdf"`
+}
+
+func (d *OrganizationInvoicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_invoices"
+}
+
+func (d *OrganizationInvoicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Organization Invoices data source. Lists the invoices of an organization with their amounts in USD.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The invoices to list: `all`, `upcoming` or `issued`. Defaults to `all`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "upcoming", "issued"),
+				},
+			},
+			"invoices": schema.ListNestedAttribute{
+				MarkdownDescription: "The invoices, in the order returned by the API.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"invoice_number": schema.StringAttribute{
+							MarkdownDescription: "The number of the invoice.",
+							Computed:            true,
+						},
+						"amount_due": schema.Float64Attribute{
+							MarkdownDescription: "The amount due in USD.",
+							Computed:            true,
+						},
+						"due_date": schema.StringAttribute{
+							MarkdownDescription: "The due date of the invoice.",
+							Computed:            true,
+						},
+						"paid_at": schema.StringAttribute{
+							MarkdownDescription: "When the invoice was paid, if it was.",
+							Computed:            true,
+						},
+						"payment_failed_at": schema.StringAttribute{
+							MarkdownDescription: "When the payment of the invoice failed, if it did.",
+							Computed:            true,
+						},
+						"invoice_pdf": schema.StringAttribute{
+							MarkdownDescription: "The URL of the invoice PDF.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"total_amount_due": schema.Float64Attribute{
+				MarkdownDescription: "The sum of the amount due of the listed invoices in USD.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *OrganizationInvoicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationInvoicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationInvoicesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := client.ListOrganizationInvoicesParams{
+		OrganizationSlug: data.OrganizationName.ValueString(),
+	}
+
+	if !data.Type.IsNull() {
+		params.Type = client.NewOptListOrganizationInvoicesType(client.ListOrganizationInvoicesType(data.Type.ValueString()))
+	}
+
+	res, err := d.client.ListOrganizationInvoices(ctx, params)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list organization invoices, got error: %s", err.Error()))
+		return
+	}
+
+	var total float64
+
+	data.Invoices = []OrganizationInvoiceModel{}
+	for _, invoice := range res.Invoices {
+		amountDue, err := amountValue(invoice.AmountDue.Value)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse amount due of invoice %s, got error: %s", invoice.InvoiceNumber.Value, err.Error()))
+			return
+		}
+
+		total += amountDue.ValueFloat64()
+
+		data.Invoices = append(data.Invoices, OrganizationInvoiceModel{
+			InvoiceNumber:   types.StringValue(invoice.InvoiceNumber.Value),
+			AmountDue:       amountDue,
+			DueDate:         optionalString(invoice.DueDate),
+			PaidAt:          optionalString(invoice.PaidAt),
+			PaymentFailedAt: optionalString(invoice.PaymentFailedAt),
+			InvoicePdf:      optionalString(invoice.InvoicePdf),
+		})
+	}
+
+	data.TotalAmountDue = types.Float64Value(total)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// optionalString returns the value, or null if it is missing or empty.
+func optionalString(value client.OptString) types.String {
+	if value.Value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value.Value)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"terraform-provider-turso/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationInvoicesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				data "turso_organization_invoices" "test" {
+					organization_name = "jpedroh"
+				}`,
+				Check: resource.TestCheckResourceAttrSet("data.turso_organization_invoices.test", "total_amount_due"),
+			},
+		},
+	})
+}
+
+func TestOrganizationInvoicesDataSourceAmounts(t *testing.T) {
+	config, handler, _ := newFaultyProviderConfig(t, 0)
+
+	invoices := []struct {
+		invoice  client.ListOrganizationInvoicesOKInvoicesItem
+		upcoming bool
+	}{
+		{
+			invoice: client.ListOrganizationInvoicesOKInvoicesItem{
+				InvoiceNumber: client.NewOptString("INV-1"),
+				AmountDue:     client.NewOptString("10.29"),
+				DueDate:       client.NewOptString("2024-01-01T05:00:00+00:00"),
+				PaidAt:        client.NewOptString("2024-01-01T06:00:00+00:00"),
+				InvoicePdf:    client.NewOptString("https://example.com/INV-1.pdf"),
+			},
+		},
+		{
+			invoice: client.ListOrganizationInvoicesOKInvoicesItem{
+				InvoiceNumber: client.NewOptString("INV-2"),
+				AmountDue:     client.NewOptString("$1,029.50"),
+				DueDate:       client.NewOptString("2024-02-01T05:00:00+00:00"),
+			},
+			upcoming: true,
+		},
+	}
+
+	for _, invoice := range invoices {
+		if err := handler.AddInvoice("jpedroh", invoice.invoice, invoice.upcoming); err != nil {
+			t.Fatalf("unable to seed invoice: %s", err)
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				data "turso_organization_invoices" "test" {
+					organization_name = "jpedroh"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.turso_organization_invoices.test", "invoices.#", "2"),
+					resource.TestCheckResourceAttr("data.turso_organization_invoices.test", "invoices.0.amount_due", "10.29"),
+					resource.TestCheckResourceAttr("data.turso_organization_invoices.test", "invoices.0.paid_at", "2024-01-01T06:00:00+00:00"),
+					resource.TestCheckNoResourceAttr("data.turso_organization_invoices.test", "invoices.1.paid_at"),
+					resource.TestCheckResourceAttr("data.turso_organization_invoices.test", "invoices.1.amount_due", "1029.5"),
+					resource.TestCheckResourceAttr("data.turso_organization_invoices.test", "total_amount_due", "1039.79"),
+				),
+			},
+			{
+				Config: config + `
+				data "turso_organization_invoices" "test" {
+					organization_name = "jpedroh"
+					type              = "upcoming"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.turso_organization_invoices.test", "invoices.#", "1"),
+					resource.TestCheckResourceAttr("data.turso_organization_invoices.test", "invoices.0.invoice_number", "INV-2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-turso/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &OrganizationMemberResource{}
+var _ resource.ResourceWithImportState = &OrganizationMemberResource{}
+
+func NewOrganizationMemberResource() resource.Resource {
+	return &OrganizationMemberResource{}
+}
+
+type OrganizationMemberResource struct {
+	client *client.Client
+}
+
+type OrganizationMemberResourceModel struct {
+	OrganizationName types.String `tfsdk:"organization_name"`
+	Username         types.String `tfsdk:"username"`
+	Role             types.String `tfsdk:"role"`
+	Email            types.String `tfsdk:"email"`
+}
+
+func (r *OrganizationMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_member"
+}
+
+func (r *OrganizationMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Organization Member resource",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username of an existing Turso user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The role of the member (admin, member or viewer). Changing it updates the member in place.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("admin", "member", "viewer"),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email of the member.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.AddOrganizationMember(ctx, &client.AddOrganizationMemberReq{
+		Username: client.NewOptString(data.Username.ValueString()),
+		Role:     client.NewOptAddOrganizationMemberReqRole(client.AddOrganizationMemberReqRole(data.Role.ValueString())),
+	}, client.AddOrganizationMemberParams{
+		OrganizationSlug: data.OrganizationName.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add organization member, got error: %s", err.Error()))
+		return
+	}
+
+	switch p := res.(type) {
+	case *client.AddOrganizationMemberOK:
+		data.Role = types.StringValue(string(p.Role.Value))
+	case *client.AddOrganizationMemberConflict:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add organization member, got error: %s", p.Error.Value))
+		return
+	case *client.AddOrganizationMemberNotFound:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add organization member, got error: %s", p.Error.Value))
+		return
+	}
+
+	// The add response does not include the email, so it is read back.
+	member, err := r.readMember(ctx, data.OrganizationName.ValueString(), data.Username.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization member, got error: %s", err.Error()))
+		return
+	}
+
+	if member == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Organization member %s was not found after being added", data.Username.ValueString()))
+		return
+	}
+
+	data.Email = types.StringValue(member.Email.Value)
+
+	tflog.Trace(ctx, "created organization member resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := r.readMember(ctx, data.OrganizationName.ValueString(), data.Username.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization member, got error: %s", err.Error()))
+		return
+	}
+
+	if member == nil {
+		// The member was removed outside of Terraform.
+		tflog.Warn(ctx, fmt.Sprintf("Organization member %s not found, removing it from state", data.Username.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Role = types.StringValue(string(member.Role.Value))
+	data.Email = types.StringValue(member.Email.Value)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrganizationMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.UpdateMemberRole(ctx, &client.UpdateMemberRoleReq{
+		Role: client.UpdateMemberRoleReqRole(data.Role.ValueString()),
+	}, client.UpdateMemberRoleParams{
+		OrganizationSlug: data.OrganizationName.ValueString(),
+		Username:         data.Username.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update member role, got error: %s", err.Error()))
+		return
+	}
+
+	switch p := res.(type) {
+	case *client.UpdateMemberRoleOK:
+		data.Role = types.StringValue(string(p.Member.Value.Role.Value))
+		data.Email = types.StringValue(p.Member.Value.Email.Value)
+	case *client.UpdateMemberRoleForbidden:
+		resp.Diagnostics.AddError(
+			"Forbidden",
+			fmt.Sprintf("Unable to update member role, got error: %s. Only admins can change roles, and the role of the organization owner cannot be changed.", p.Error.Value),
+		)
+		return
+	case *client.UpdateMemberRoleNotFound:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update member role, got error: %s", p.Error.Value))
+		return
+	case *client.UpdateMemberRoleBadRequest:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update member role, got error: %s", p.Error.Value))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrganizationMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.RemoveOrganizationMember(ctx, client.RemoveOrganizationMemberParams{
+		OrganizationSlug: data.OrganizationName.ValueString(),
+		Username:         data.Username.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove organization member, got error: %s", err.Error()))
+		return
+	}
+
+	if _, ok := res.(*client.RemoveOrganizationMemberNotFound); ok {
+		tflog.Warn(ctx, fmt.Sprintf("Organization member %s was already removed", data.Username.ValueString()))
+	}
+}
+
+func (r *OrganizationMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organization/username. Got: %q", req.ID),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing organization member %s/%s", idParts[0], idParts[1]))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), idParts[1])...)
+}
+
+// readMember returns the member, or nil if the user is not a member of the
+// organization.
+func (r *OrganizationMemberResource) readMember(ctx context.Context, organizationSlug, username string) (*client.Member, error) {
+	res, err := r.client.GetOrganizationMember(ctx, client.GetOrganizationMemberParams{
+		OrganizationSlug: organizationSlug,
+		Username:         username,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch p := res.(type) {
+	case *client.GetOrganizationMemberOK:
+		return &p.Member.Value, nil
+	case *client.GetOrganizationMemberNotFound:
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf("unexpected response %T", res)
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"regexp"
+	"terraform-provider-turso/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationMemberResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "turso_organization_member" "test" {
+					organization_name = "jpedroh"
+					username	  = "tfprovidermember"
+					role		  = "member"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("turso_organization_member.test", "organization_name", "jpedroh"),
+					resource.TestCheckResourceAttr("turso_organization_member.test", "username", "tfprovidermember"),
+					resource.TestCheckResourceAttr("turso_organization_member.test", "role", "member"),
+					resource.TestCheckResourceAttrSet("turso_organization_member.test", "email"),
+				),
+			},
+			{
+				Config: providerConfig + `
+				resource "turso_organization_member" "test" {
+					organization_name = "jpedroh"
+					username	  = "tfprovidermember"
+					role		  = "admin"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("turso_organization_member.test", "role", "admin"),
+				),
+			},
+			{
+				ResourceName:                         "turso_organization_member.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "jpedroh/tfprovidermember",
+				ImportStateVerifyIdentifierAttribute: "username",
+			},
+		},
+	})
+}
+
+func TestOrganizationMemberResourceAlreadyMember(t *testing.T) {
+	config, _, _ := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				resource "turso_organization_member" "test" {
+					organization_name = "jpedroh"
+					username	  = "jpedroh"
+					role		  = "admin"
+				}`,
+				ExpectError: regexp.MustCompile(`Unable to add organization member, got error: user jpedroh is already a\s+member`),
+			},
+		},
+	})
+}
+
+func TestOrganizationMemberResourceUpdateForbidden(t *testing.T) {
+	config, _, faults := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				resource "turso_organization_member" "test" {
+					organization_name = "jpedroh"
+					username	  = "tfprovidermember"
+					role		  = "member"
+				}`,
+			},
+			{
+				PreConfig: func() {
+					faults.Fail(client.UpdateMemberRoleOperation, 1, http.StatusForbidden)
+				},
+				Config: config + `
+				resource "turso_organization_member" "test" {
+					organization_name = "jpedroh"
+					username	  = "tfprovidermember"
+					role		  = "admin"
+				}`,
+				ExpectError: regexp.MustCompile("Forbidden"),
+			},
+		},
+	})
+}
+
+func TestOrganizationMemberResourceReadRemovesRemovedMember(t *testing.T) {
+	config, _, faults := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				resource "turso_organization_member" "test" {
+					organization_name = "jpedroh"
+					username	  = "tfprovidermember"
+					role		  = "member"
+				}`,
+			},
+			{
+				PreConfig: func() {
+					faults.Fail(client.GetOrganizationMemberOperation, -1, http.StatusNotFound)
+				},
+				Config: config + `
+				resource "turso_organization_member" "test" {
+					organization_name = "jpedroh"
+					username	  = "tfprovidermember"
+					role		  = "member"
+				}`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestOrganizationMemberResourceInvalidImportId(t *testing.T) {
+	config, _, _ := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				resource "turso_organization_member" "test" {
+					organization_name = "jpedroh"
+					username	  = "tfprovidermember"
+					role		  = "member"
+				}`,
+				ResourceName:  "turso_organization_member.test",
+				ImportState:   true,
+				ImportStateId: "tfprovidermember",
+				ExpectError:   regexp.MustCompile("Unexpected Import Identifier"),
+			},
+		},
+	})
+}
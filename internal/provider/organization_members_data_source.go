@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"terraform-provider-turso/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &OrganizationMembersDataSource{}
+
+func NewOrganizationMembersDataSource() datasource.DataSource {
+	return &OrganizationMembersDataSource{}
+}
+
+// OrganizationMembersDataSource defines the data source implementation.
+type OrganizationMembersDataSource struct {
+	client *client.Client
+}
+
+// OrganizationMembersDataSourceModel describes the data source data model.
+type OrganizationMembersDataSourceModel struct {
+	OrganizationName types.String `tfsdk:"organization_name"`
+
+	// Computed
+	Members []OrganizationMemberModel `tfsdk:"members"`
+}
+
+type OrganizationMemberModel struct {
+	Username types.String `tfsdk:"username"`
+	Role     types.String `tfsdk:"role"`
+	Email    types.String `tfsdk:"email"`
+}
+
+func (d *OrganizationMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_members"
+}
+
+func (d *OrganizationMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Organization Members data source. Lists everyone with access to an organization, including its owner.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization.",
+				Required:            true,
+			},
+			"members": schema.ListNestedAttribute{
+				MarkdownDescription: "The members of the organization, sorted by username.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							MarkdownDescription: "The username of the member.",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "The role of the member (owner, admin, member or viewer).",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email of the member.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrganizationMembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationMembersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.client.ListOrganizationMembers(ctx, client.ListOrganizationMembersParams{
+		OrganizationSlug: data.OrganizationName.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list organization members, got error: %s", err.Error()))
+		return
+	}
+
+	data.Members = []OrganizationMemberModel{}
+	for _, member := range res.Members {
+		data.Members = append(data.Members, OrganizationMemberModel{
+			Username: types.StringValue(member.Username.Value),
+			Role:     types.StringValue(string(member.Role.Value)),
+			Email:    types.StringValue(member.Email.Value),
+		})
+	}
+
+	sort.Slice(data.Members, func(i, j int) bool {
+		return data.Members[i].Username.ValueString() < data.Members[j].Username.ValueString()
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
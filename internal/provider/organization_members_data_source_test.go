@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationMembersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "turso_organization_members" "test" { organization_name = "jpedroh" }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.turso_organization_members.test", "organization_name", "jpedroh"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.turso_organization_members.test", "members.*", map[string]string{
+						"username": "jpedroh",
+						"role":     "owner",
+					}),
+				),
+			},
+		},
+	})
+}
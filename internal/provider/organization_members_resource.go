@@ -0,0 +1,438 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"terraform-provider-turso/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &OrganizationMembersResource{}
+var _ resource.ResourceWithImportState = &OrganizationMembersResource{}
+
+func NewOrganizationMembersResource() resource.Resource {
+	return &OrganizationMembersResource{}
+}
+
+type OrganizationMembersResource struct {
+	client *client.Client
+}
+
+type OrganizationMembersResourceModel struct {
+	OrganizationName types.String `tfsdk:"organization_name"`
+	Members          types.Map    `tfsdk:"members"`
+	TokenUsername    types.String `tfsdk:"token_username"`
+	Owner            types.String `tfsdk:"owner"`
+}
+
+// memberChanges are the API calls needed to turn the current member list of
+// an organization into the desired one.
+type memberChanges struct {
+	Add    map[string]string
+	Update map[string]string
+	Remove []string
+}
+
+func (r *OrganizationMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_members"
+}
+
+func (r *OrganizationMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritative Organization Members resource. Owns the full member list of an organization: " +
+			"members that are not declared are removed. The organization owner is managed by Turso and is never changed. " +
+			"Removing the user the API token belongs to, or removing or demoting the last admin, is refused. " +
+			"Destroying this resource stops managing the members without removing them. " +
+			"Do not use it together with `turso_organization_member` for the same organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.MapAttribute{
+				MarkdownDescription: "The members of the organization, as a map of username to role (admin, member or viewer).",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf("admin", "member", "viewer")),
+				},
+			},
+			"token_username": schema.StringAttribute{
+				MarkdownDescription: "The username of the user the API token belongs to, which is never removed. " +
+					"Defaults to the slug of the personal organization of the token. " +
+					"If it cannot be determined, members are not removed.",
+				Optional: true,
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "The username of the organization owner.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationMembersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created organization members resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationMembersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, owner, err := r.listMembers(ctx, data.OrganizationName.ValueString())
+
+	if IsNotFoundError(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Organization %s not found, removing its members from state", data.OrganizationName.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list organization members, got error: %s", err.Error()))
+		return
+	}
+
+	membersValue, diags := types.MapValueFrom(ctx, types.StringType, members)
+	resp.Diagnostics.Append(diags...)
+
+	data.Members = membersValue
+	data.Owner = types.StringValue(owner)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrganizationMembersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No operation. Removing every member of an organization is rarely what
+	// destroying this resource is meant to do, so the members are kept.
+}
+
+func (r *OrganizationMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("organization_name"), req, resp)
+}
+
+// apply reconciles the member list of the organization with data.Members and
+// sets data.Owner. Nothing is changed if the safety checks fail.
+func (r *OrganizationMembersResource) apply(ctx context.Context, data *OrganizationMembersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	organization := data.OrganizationName.ValueString()
+
+	desired := map[string]string{}
+	diags.Append(data.Members.ElementsAs(ctx, &desired, false)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	current, owner, err := r.listMembers(ctx, organization)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list organization members, got error: %s", err.Error()))
+		return diags
+	}
+
+	if _, ok := desired[owner]; ok {
+		diags.AddError("Invalid Members", fmt.Sprintf("%s is the owner of the organization, whose role is managed by Turso. Remove it from members.", owner))
+		return diags
+	}
+
+	changes := diffMembers(current, desired)
+
+	if len(changes.Remove) > 0 {
+		tokenUsername := data.TokenUsername.ValueString()
+
+		if data.TokenUsername.IsNull() {
+			tokenUsername, err = r.currentUsername(ctx)
+
+			if err != nil {
+				diags.AddError("Unsafe Member Removal", fmt.Sprintf("Unable to determine the user of the API token, so no members are removed. "+
+					"Set token_username to allow removals, got error: %s", err.Error()))
+				return diags
+			}
+		}
+
+		if err := checkMemberRemovals(changes, tokenUsername); err != nil {
+			diags.AddError("Unsafe Member Removal", err.Error())
+			return diags
+		}
+	}
+
+	if err := checkRemainingAdmins(current, desired); err != nil {
+		diags.AddError("Unsafe Member Removal", err.Error())
+		return diags
+	}
+
+	for _, username := range sortedKeys(changes.Add) {
+		res, err := r.client.AddOrganizationMember(ctx, &client.AddOrganizationMemberReq{
+			Username: client.NewOptString(username),
+			Role:     client.NewOptAddOrganizationMemberReqRole(client.AddOrganizationMemberReqRole(changes.Add[username])),
+		}, client.AddOrganizationMemberParams{
+			OrganizationSlug: organization,
+		})
+
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to add organization member %s, got error: %s", username, err.Error()))
+			return diags
+		}
+
+		switch p := res.(type) {
+		case *client.AddOrganizationMemberConflict:
+			diags.AddError("Client Error", fmt.Sprintf("Unable to add organization member %s, got error: %s", username, p.Error.Value))
+			return diags
+		case *client.AddOrganizationMemberNotFound:
+			diags.AddError("Client Error", fmt.Sprintf("Unable to add organization member %s, got error: %s", username, p.Error.Value))
+			return diags
+		}
+	}
+
+	// Promotions are applied before demotions so that the organization is
+	// never left without an admin.
+	updates := sortedKeys(changes.Update)
+	sort.SliceStable(updates, func(i, j int) bool {
+		return changes.Update[updates[i]] == "admin" && changes.Update[updates[j]] != "admin"
+	})
+
+	for _, username := range updates {
+		res, err := r.client.UpdateMemberRole(ctx, &client.UpdateMemberRoleReq{
+			Role: client.UpdateMemberRoleReqRole(changes.Update[username]),
+		}, client.UpdateMemberRoleParams{
+			OrganizationSlug: organization,
+			Username:         username,
+		})
+
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update the role of %s, got error: %s", username, err.Error()))
+			return diags
+		}
+
+		switch p := res.(type) {
+		case *client.UpdateMemberRoleForbidden:
+			diags.AddError("Forbidden", fmt.Sprintf("Unable to update the role of %s, got error: %s", username, p.Error.Value))
+			return diags
+		case *client.UpdateMemberRoleNotFound:
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update the role of %s, got error: %s", username, p.Error.Value))
+			return diags
+		case *client.UpdateMemberRoleBadRequest:
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update the role of %s, got error: %s", username, p.Error.Value))
+			return diags
+		}
+	}
+
+	for _, username := range changes.Remove {
+		res, err := r.client.RemoveOrganizationMember(ctx, client.RemoveOrganizationMemberParams{
+			OrganizationSlug: organization,
+			Username:         username,
+		})
+
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to remove organization member %s, got error: %s", username, err.Error()))
+			return diags
+		}
+
+		if p, ok := res.(*client.RemoveOrganizationMemberNotFound); ok {
+			diags.AddWarning("Member Already Removed", fmt.Sprintf("Organization member %s was already removed: %s", username, p.Error.Value))
+		}
+	}
+
+	data.Owner = types.StringValue(owner)
+
+	return diags
+}
+
+// listMembers returns the role of every member of the organization except
+// its owner, and the username of the owner.
+func (r *OrganizationMembersResource) listMembers(ctx context.Context, organization string) (map[string]string, string, error) {
+	res, err := r.client.ListOrganizationMembers(ctx, client.ListOrganizationMembersParams{
+		OrganizationSlug: organization,
+	})
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	members := map[string]string{}
+	owner := ""
+
+	for _, member := range res.Members {
+		if member.Role.Value == client.MemberRoleOwner {
+			owner = member.Username.Value
+			continue
+		}
+		members[member.Username.Value] = string(member.Role.Value)
+	}
+
+	return members, owner, nil
+}
+
+// currentUsername returns the user the API token belongs to. Every Turso user
+// has a personal organization whose slug is their username.
+func (r *OrganizationMembersResource) currentUsername(ctx context.Context) (string, error) {
+	organizations, err := r.client.ListOrganizations(ctx)
+
+	if err != nil {
+		return "", err
+	}
+
+	for _, organization := range organizations {
+		if organization.Type.Value == client.OrganizationTypePersonal {
+			return organization.Slug.Value, nil
+		}
+	}
+
+	return "", fmt.Errorf("no personal organization found")
+}
+
+func diffMembers(current, desired map[string]string) memberChanges {
+	changes := memberChanges{
+		Add:    map[string]string{},
+		Update: map[string]string{},
+	}
+
+	for username, role := range desired {
+		currentRole, ok := current[username]
+		switch {
+		case !ok:
+			changes.Add[username] = role
+		case currentRole != role:
+			changes.Update[username] = role
+		}
+	}
+
+	for _, username := range sortedKeys(current) {
+		if _, ok := desired[username]; !ok {
+			changes.Remove = append(changes.Remove, username)
+		}
+	}
+
+	return changes
+}
+
+// checkMemberRemovals refuses to remove the user the API token belongs to,
+// which would lock Terraform out of the organization.
+func checkMemberRemovals(changes memberChanges, tokenUsername string) error {
+	for _, username := range changes.Remove {
+		if username == tokenUsername {
+			return fmt.Errorf("refusing to remove %s from the organization, as it is the user of the API token. Add it to members to keep it", username)
+		}
+	}
+
+	return nil
+}
+
+// checkRemainingAdmins refuses to remove or demote the last admin of an
+// organization that has admins.
+func checkRemainingAdmins(current, desired map[string]string) error {
+	var admins []string
+	for _, username := range sortedKeys(current) {
+		if current[username] == "admin" {
+			admins = append(admins, username)
+		}
+	}
+
+	if len(admins) == 0 {
+		return nil
+	}
+
+	for _, role := range desired {
+		if role == "admin" {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("refusing to leave the organization without admins, as %v would be removed or demoted. Keep at least one admin in members", admins)
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
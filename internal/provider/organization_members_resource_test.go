@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"terraform-provider-turso/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationMembersResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "turso_organization_members" "test" {
+					organization_name = "jpedroh"
+					members = {
+						tfprovideradmin  = "admin"
+						tfprovidermember = "member"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("turso_organization_members.test", "organization_name", "jpedroh"),
+					resource.TestCheckResourceAttr("turso_organization_members.test", "owner", "jpedroh"),
+					resource.TestCheckResourceAttr("turso_organization_members.test", "members.%", "2"),
+					resource.TestCheckResourceAttr("turso_organization_members.test", "members.tfprovideradmin", "admin"),
+					resource.TestCheckResourceAttr("turso_organization_members.test", "members.tfprovidermember", "member"),
+				),
+			},
+			{
+				Config: providerConfig + `
+				resource "turso_organization_members" "test" {
+					organization_name = "jpedroh"
+					members = {
+						tfprovideradmin = "admin"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("turso_organization_members.test", "members.%", "1"),
+					resource.TestCheckResourceAttr("turso_organization_members.test", "members.tfprovideradmin", "admin"),
+				),
+			},
+			{
+				ResourceName:                         "turso_organization_members.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "jpedroh",
+				ImportStateVerifyIdentifierAttribute: "organization_name",
+			},
+		},
+	})
+}
+
+func TestOrganizationMembersResourceRefusesUnsafeRemovals(t *testing.T) {
+	config, handler, _ := newFaultyProviderConfig(t, 0)
+
+	handler.AddOrganization("acme", "Acme", client.OrganizationTypeTeam)
+	for username, role := range map[string]client.MemberRole{
+		"boss":             client.MemberRoleOwner,
+		"jpedroh":          client.MemberRoleMember,
+		"tfprovideradmin":  client.MemberRoleAdmin,
+		"tfprovidermember": client.MemberRoleMember,
+	} {
+		if err := handler.AddMember("acme", username, role); err != nil {
+			t.Fatalf("unable to seed member: %s", err)
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				resource "turso_organization_members" "test" {
+					organization_name = "acme"
+					members = {
+						tfprovideradmin  = "admin"
+						tfprovidermember = "member"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`refusing to remove jpedroh`),
+			},
+			{
+				Config: config + `
+				resource "turso_organization_members" "test" {
+					organization_name = "acme"
+					token_username    = "tfprovidermember"
+					members = {
+						jpedroh         = "member"
+						tfprovideradmin = "admin"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`refusing to remove tfprovidermember`),
+			},
+			{
+				Config: config + `
+				resource "turso_organization_members" "test" {
+					organization_name = "acme"
+					members = {
+						jpedroh          = "member"
+						tfprovidermember = "member"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`refusing to leave the organization without\s+admins`),
+			},
+			{
+				Config: config + `
+				resource "turso_organization_members" "test" {
+					organization_name = "acme"
+					members = {
+						boss             = "admin"
+						jpedroh          = "member"
+						tfprovideradmin  = "admin"
+						tfprovidermember = "member"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`boss is the owner of the organization`),
+			},
+			{
+				Config: config + `
+				resource "turso_organization_members" "test" {
+					organization_name = "acme"
+					members = {
+						jpedroh         = "admin"
+						tfprovideradmin = "member"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("turso_organization_members.test", "owner", "boss"),
+					resource.TestCheckResourceAttr("turso_organization_members.test", "members.%", "2"),
+					resource.TestCheckResourceAttr("turso_organization_members.test", "members.jpedroh", "admin"),
+					resource.TestCheckResourceAttr("turso_organization_members.test", "members.tfprovideradmin", "member"),
+				),
+			},
+		},
+	})
+}
+
+func TestOrganizationMembersResourceRemovalError(t *testing.T) {
+	config, handler, faults := newFaultyProviderConfig(t, 0)
+
+	if err := handler.AddMember("jpedroh", "tfprovidermember", client.MemberRoleMember); err != nil {
+		t.Fatalf("unable to seed member: %s", err)
+	}
+	faults.Fail(client.RemoveOrganizationMemberOperation, 1, http.StatusForbidden)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				resource "turso_organization_members" "test" {
+					organization_name = "jpedroh"
+					members = {}
+				}`,
+				ExpectError: regexp.MustCompile(`Unable to remove organization member tfprovidermember`),
+			},
+		},
+	})
+}
+
+func TestOrganizationMembersResourceUnknownTokenUser(t *testing.T) {
+	config, handler, faults := newFaultyProviderConfig(t, 0)
+
+	if err := handler.AddMember("jpedroh", "tfprovidermember", client.MemberRoleMember); err != nil {
+		t.Fatalf("unable to seed member: %s", err)
+	}
+	faults.Fail(client.ListOrganizationsOperation, -1, http.StatusInternalServerError)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				resource "turso_organization_members" "test" {
+					organization_name = "jpedroh"
+					members = {}
+				}`,
+				ExpectError: regexp.MustCompile(`Unable to determine the user of the API token`),
+			},
+			{
+				Config: config + `
+				resource "turso_organization_members" "test" {
+					organization_name = "jpedroh"
+					token_username    = "jpedroh"
+					members = {}
+				}`,
+				Check: resource.TestCheckResourceAttr("turso_organization_members.test", "members.%", "0"),
+			},
+		},
+	})
+}
+
+func TestCheckMemberRemovals(t *testing.T) {
+	changes := memberChanges{Remove: []string{"alice", "bob"}}
+
+	if err := checkMemberRemovals(changes, "carol"); err != nil {
+		t.Errorf("expected removals to be allowed, got %s", err)
+	}
+
+	if err := checkMemberRemovals(changes, "bob"); err == nil {
+		t.Error("expected removing the token user to be refused")
+	}
+}
+
+func TestDiffMembers(t *testing.T) {
+	current := map[string]string{"alice": "admin", "bob": "member", "carol": "member"}
+	desired := map[string]string{"alice": "admin", "bob": "admin", "dave": "viewer"}
+
+	expected := memberChanges{
+		Add:    map[string]string{"dave": "viewer"},
+		Update: map[string]string{"bob": "admin"},
+		Remove: []string{"carol"},
+	}
+
+	if actual := diffMembers(current, desired); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestCheckRemainingAdmins(t *testing.T) {
+	cases := []struct {
+		current, desired map[string]string
+		ok               bool
+	}{
+		{map[string]string{"alice": "admin"}, map[string]string{"alice": "admin"}, true},
+		{map[string]string{"alice": "admin"}, map[string]string{"bob": "admin"}, true},
+		{map[string]string{"alice": "admin"}, map[string]string{"alice": "member"}, false},
+		{map[string]string{"alice": "admin"}, map[string]string{}, false},
+		{map[string]string{"alice": "member"}, map[string]string{}, true},
+	}
+
+	for _, c := range cases {
+		if err := checkRemainingAdmins(c.current, c.desired); (err == nil) != c.ok {
+			t.Errorf("current %v, desired %v: expected ok=%t, got %v", c.current, c.desired, c.ok, err)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-turso/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &OrganizationPlansDataSource{}
+
+func NewOrganizationPlansDataSource() datasource.DataSource {
+	return &OrganizationPlansDataSource{}
+}
+
+// OrganizationPlansDataSource defines the data source implementation.
+type OrganizationPlansDataSource struct {
+	client *client.Client
+}
+
+// OrganizationPlansDataSourceModel describes the data source data model.
+type OrganizationPlansDataSourceModel struct {
+	OrganizationName types.String `tfsdk:"organization_name"`
+
+	// Computed
+	Plans []OrganizationPlanModel `tfsdk:"plans"`
+}
+
+type OrganizationPlanModel struct {
+	Name   types.String            `tfsdk:"name"`
+	Price  types.Float64           `tfsdk:"price"`
+	Prices []PlanPriceModel        `tfsdk:"prices"`
+	Quotas *OrganizationPlanQuotas `tfsdk:"quotas"`
+}
+
+type PlanPriceModel struct {
+	Value    types.Float64 `tfsdk:"value"`
+	Timeline types.String  `tfsdk:"timeline"`
+}
+
+type OrganizationPlanQuotas struct {
+	RowsRead     types.Int64 `tfsdk:"rows_read"`
+	RowsWritten  types.Int64 `tfsdk:"rows_written"`
+	Databases    types.Int64 `tfsdk:"databases"`
+	Locations    types.Int64 `tfsdk:"locations"`
+	StorageBytes types.Int64 `tfsdk:"storage_bytes"`
+	Groups       types.Int64 `tfsdk:"groups"`
+	BytesSynced  types.Int64 `tfsdk:"bytes_synced"`
+}
+
+func (d *OrganizationPlansDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_plans"
+}
+
+func (d *OrganizationPlansDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Organization Plans data source. Lists the plans available to an organization with their prices in USD and quotas.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization.",
+				Required:            true,
+			},
+			"plans": schema.ListNestedAttribute{
+				MarkdownDescription: "The plans, in the order returned by the API.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the plan.",
+							Computed:            true,
+						},
+						"price": schema.Float64Attribute{
+							MarkdownDescription: "The monthly price of the plan in USD.",
+							Computed:            true,
+						},
+						"prices": schema.ListNestedAttribute{
+							MarkdownDescription: "The prices of the plan per billing cycle.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"value": schema.Float64Attribute{
+										MarkdownDescription: "The price in USD.",
+										Computed:            true,
+									},
+									"timeline": schema.StringAttribute{
+										MarkdownDescription: "The billing cycle, such as `monthly`.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"quotas": schema.SingleNestedAttribute{
+							MarkdownDescription: "The quotas of the plan. A null quota is unlimited.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"rows_read": schema.Int64Attribute{
+									MarkdownDescription: "The number of rows that can be read per month.",
+									Computed:            true,
+								},
+								"rows_written": schema.Int64Attribute{
+									MarkdownDescription: "The number of rows that can be written per month.",
+									Computed:            true,
+								},
+								"databases": schema.Int64Attribute{
+									MarkdownDescription: "The number of databases.",
+									Computed:            true,
+								},
+								"locations": schema.Int64Attribute{
+									MarkdownDescription: "The number of locations.",
+									Computed:            true,
+								},
+								"storage_bytes": schema.Int64Attribute{
+									MarkdownDescription: "The total storage in bytes.",
+									Computed:            true,
+								},
+								"groups": schema.Int64Attribute{
+									MarkdownDescription: "The number of groups.",
+									Computed:            true,
+								},
+								"bytes_synced": schema.Int64Attribute{
+									MarkdownDescription: "The number of bytes that can be synced per month.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrganizationPlansDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationPlansDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationPlansDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.client.ListOrganizationPlans(ctx, client.ListOrganizationPlansParams{
+		OrganizationSlug: data.OrganizationName.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list organization plans, got error: %s", err.Error()))
+		return
+	}
+
+	data.Plans = []OrganizationPlanModel{}
+	for _, plan := range res.Plans {
+		price, err := amountValue(plan.Price.Value)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse price of plan %s, got error: %s", plan.Name.Value, err.Error()))
+			return
+		}
+
+		prices := []PlanPriceModel{}
+		for _, p := range plan.Prices {
+			value, err := amountValue(p.Value.Value)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse price of plan %s, got error: %s", plan.Name.Value, err.Error()))
+				return
+			}
+
+			prices = append(prices, PlanPriceModel{
+				Value:    value,
+				Timeline: types.StringValue(p.Timeline.Value),
+			})
+		}
+
+		quotas := planQuotas(plan.Quotas.Value)
+
+		data.Plans = append(data.Plans, OrganizationPlanModel{
+			Name:   types.StringValue(plan.Name.Value),
+			Price:  price,
+			Prices: prices,
+			Quotas: &OrganizationPlanQuotas{
+				RowsRead:     quotaValue(quotas, "rows_read"),
+				RowsWritten:  quotaValue(quotas, "rows_written"),
+				Databases:    quotaValue(quotas, "databases"),
+				Locations:    quotaValue(quotas, "locations"),
+				StorageBytes: quotaValue(quotas, "storage_bytes"),
+				Groups:       quotaValue(quotas, "groups"),
+				BytesSynced:  quotaValue(quotas, "bytes_synced"),
+			},
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// quotaValue returns the quota of a dimension, or null if it is unlimited.
+func quotaValue(quotas map[string]int, dimension string) types.Int64 {
+	quota, ok := quotas[dimension]
+	if !ok {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(quota))
+}
+
+// amountValue parses a formatted USD amount, which is null if empty.
+func amountValue(amount string) (types.Float64, error) {
+	value, ok, err := ParseAmount(amount)
+	if err != nil || !ok {
+		return types.Float64Null(), err
+	}
+
+	return types.Float64Value(value), nil
+}
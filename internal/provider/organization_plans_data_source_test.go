@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationPlansDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				data "turso_organization_plans" "test" {
+					organization_name = "jpedroh"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.turso_organization_plans.test", "plans.0.name"),
+					resource.TestCheckResourceAttrSet("data.turso_organization_plans.test", "plans.0.price"),
+					resource.TestCheckResourceAttrSet("data.turso_organization_plans.test", "plans.0.quotas.rows_read"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.turso_organization_plans.test", "plans.*", map[string]string{
+						"name":                "starter",
+						"price":               "0",
+						"quotas.rows_written": "25000000",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-turso/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &OrganizationResource{}
+var _ resource.ResourceWithImportState = &OrganizationResource{}
+
+func NewOrganizationResource() resource.Resource {
+	return &OrganizationResource{}
+}
+
+type OrganizationResource struct {
+	client *client.Client
+}
+
+type OrganizationResourceModel struct {
+	Slug     types.String `tfsdk:"slug"`
+	Overages types.Bool   `tfsdk:"overages"`
+
+	// Computed
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+	BlockedReads  types.Bool   `tfsdk:"blocked_reads"`
+	BlockedWrites types.Bool   `tfsdk:"blocked_writes"`
+	PlanId        types.String `tfsdk:"plan_id"`
+	PlanTimeline  types.String `tfsdk:"plan_timeline"`
+	Platform      types.String `tfsdk:"platform"`
+}
+
+func (r *OrganizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+func (r *OrganizationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Organization resource. Adopts an existing organization to manage its settings. " +
+			"Organizations cannot be created or deleted through the API, so destroying this resource leaves the organization untouched.",
+
+		Attributes: map[string]schema.Attribute{
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "The organization slug. This will be your username for `personal` accounts.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"overages": schema.BoolAttribute{
+				MarkdownDescription: "Whether usage beyond the plan quotas is billed as overages. If not set, the current setting is kept.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The organization name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of account this organization is. Will always be `personal` or `team`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"blocked_reads": schema.BoolAttribute{
+				MarkdownDescription: "Whether reads are blocked for the organization.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"blocked_writes": schema.BoolAttribute{
+				MarkdownDescription: "Whether writes are blocked for the organization.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"plan_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the plan the organization is subscribed to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"plan_timeline": schema.StringAttribute{
+				MarkdownDescription: "The billing cycle of the plan, such as `monthly`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"platform": schema.StringAttribute{
+				MarkdownDescription: "The platform the organization is billed through, if any, such as `vercel`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetOrganization(ctx, client.GetOrganizationParams{
+		OrganizationSlug: data.Slug.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization, got error: %s", err.Error()))
+		return
+	}
+
+	var organization client.Organization
+
+	switch p := res.(type) {
+	case *client.GetOrganizationOK:
+		organization = p.Organization.Value
+	case *client.GetOrganizationNotFound:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to adopt organization, got error: %s", p.Error.Value))
+		return
+	}
+
+	if !data.Overages.IsUnknown() && data.Overages.ValueBool() != organization.Overages.Value {
+		organization, err = r.updateOverages(ctx, data.Slug.ValueString(), data.Overages.ValueBool())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update organization, got error: %s", err.Error()))
+			return
+		}
+	}
+
+	data.setOrganization(organization)
+
+	tflog.Trace(ctx, "adopted organization resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.GetOrganization(ctx, client.GetOrganizationParams{
+		OrganizationSlug: data.Slug.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization, got error: %s", err.Error()))
+		return
+	}
+
+	switch p := res.(type) {
+	case *client.GetOrganizationOK:
+		data.setOrganization(p.Organization.Value)
+	case *client.GetOrganizationNotFound:
+		tflog.Warn(ctx, fmt.Sprintf("Organization %s not found, removing it from state", data.Slug.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrganizationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, err := r.updateOverages(ctx, data.Slug.ValueString(), data.Overages.ValueBool())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update organization, got error: %s", err.Error()))
+		return
+	}
+
+	data.setOrganization(organization)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// No operation, as organizations cannot be deleted through the API.
+}
+
+func (r *OrganizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("slug"), req, resp)
+}
+
+func (r *OrganizationResource) updateOverages(ctx context.Context, slug string, overages bool) (client.Organization, error) {
+	res, err := r.client.UpdateOrganization(ctx, &client.UpdateOrganizationReq{
+		Overages: client.NewOptBool(overages),
+	}, client.UpdateOrganizationParams{
+		OrganizationSlug: slug,
+	})
+
+	if err != nil {
+		return client.Organization{}, err
+	}
+
+	return res.Organization.Value, nil
+}
+
+func (data *OrganizationResourceModel) setOrganization(organization client.Organization) {
+	data.Name = types.StringValue(organization.Name.Value)
+	data.Type = types.StringValue(string(organization.Type.Value))
+	data.Overages = types.BoolValue(organization.Overages.Value)
+	data.BlockedReads = types.BoolValue(organization.BlockedReads.Value)
+	data.BlockedWrites = types.BoolValue(organization.BlockedWrites.Value)
+	data.PlanId = types.StringValue(organization.PlanID.Value)
+	data.PlanTimeline = types.StringValue(organization.PlanTimeline.Value)
+	data.Platform = types.StringValue(organization.Platform.Value)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				resource "turso_organization" "test" {
+					slug     = "jpedroh"
+					overages = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("turso_organization.test", "slug", "jpedroh"),
+					resource.TestCheckResourceAttr("turso_organization.test", "name", "jpedroh"),
+					resource.TestCheckResourceAttr("turso_organization.test", "type", "personal"),
+					resource.TestCheckResourceAttr("turso_organization.test", "overages", "true"),
+					resource.TestCheckResourceAttrSet("turso_organization.test", "plan_id"),
+				),
+			},
+			{
+				Config: providerConfig + `
+				resource "turso_organization" "test" {
+					slug     = "jpedroh"
+					overages = false
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("turso_organization.test", "overages", "false"),
+				),
+			},
+			{
+				ResourceName:                         "turso_organization.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "jpedroh",
+				ImportStateVerifyIdentifierAttribute: "slug",
+			},
+		},
+	})
+}
+
+func TestOrganizationResourceNotFound(t *testing.T) {
+	config, _, _ := newFaultyProviderConfig(t, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				resource "turso_organization" "test" {
+					slug = "missing"
+				}`,
+				ExpectError: regexp.MustCompile("Unable to adopt organization"),
+			},
+		},
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-turso/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &OrganizationSubscriptionDataSource{}
+
+func NewOrganizationSubscriptionDataSource() datasource.DataSource {
+	return &OrganizationSubscriptionDataSource{}
+}
+
+// OrganizationSubscriptionDataSource defines the data source implementation.
+type OrganizationSubscriptionDataSource struct {
+	client *client.Client
+}
+
+// OrganizationSubscriptionDataSourceModel describes the data source data model.
+type OrganizationSubscriptionDataSourceModel struct {
+	OrganizationName types.String `tfsdk:"organization_name"`
+
+	// Computed
+	Name     types.String `tfsdk:"name"`
+	Plan     types.String `tfsdk:"plan"`
+	Timeline types.String `tfsdk:"timeline"`
+	Overages types.Bool   `tfsdk:"overages"`
+}
+
+func (d *OrganizationSubscriptionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_subscription"
+}
+
+func (d *OrganizationSubscriptionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Organization Subscription data source. Reads the plan an organization is subscribed to.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the subscription.",
+				Computed:            true,
+			},
+			"plan": schema.StringAttribute{
+				MarkdownDescription: "The plan the organization is subscribed to. Matches the `name` of an entry of `turso_organization_plans`.",
+				Computed:            true,
+			},
+			"timeline": schema.StringAttribute{
+				MarkdownDescription: "The billing cycle of the subscription, such as `monthly`.",
+				Computed:            true,
+			},
+			"overages": schema.BoolAttribute{
+				MarkdownDescription: "Whether usage beyond the plan quotas is billed as overages.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *OrganizationSubscriptionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationSubscriptionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationSubscriptionDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.client.GetOrganizationSubscription(ctx, client.GetOrganizationSubscriptionParams{
+		OrganizationSlug: data.OrganizationName.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization subscription, got error: %s", err.Error()))
+		return
+	}
+
+	subscription := res.Subscription.Value
+	data.Name = types.StringValue(subscription.Name.Value)
+	data.Plan = types.StringValue(subscription.Plan.Value)
+	data.Timeline = types.StringValue(subscription.Timeline.Value)
+	data.Overages = types.BoolValue(subscription.Overages.Value)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
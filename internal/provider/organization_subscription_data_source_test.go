@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationSubscriptionDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				data "turso_organization_subscription" "test" {
+					organization_name = "jpedroh"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.turso_organization_subscription.test", "plan"),
+					resource.TestCheckResourceAttrSet("data.turso_organization_subscription.test", "timeline"),
+					resource.TestCheckResourceAttrSet("data.turso_organization_subscription.test", "overages"),
+				),
+			},
+		},
+	})
+}
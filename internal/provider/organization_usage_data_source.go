@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-turso/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &OrganizationUsageDataSource{}
+
+// usageDimensions are the usage dimensions that plans set quotas for.
+var usageDimensions = []string{"rows_read", "rows_written", "databases", "locations", "storage_bytes", "groups", "bytes_synced"}
+
+func NewOrganizationUsageDataSource() datasource.DataSource {
+	return &OrganizationUsageDataSource{}
+}
+
+// OrganizationUsageDataSource defines the data source implementation.
+type OrganizationUsageDataSource struct {
+	client *client.Client
+}
+
+// OrganizationUsageDataSourceModel describes the data source data model.
+type OrganizationUsageDataSourceModel struct {
+	OrganizationName types.String             `tfsdk:"organization_name"`
+	FailAbovePercent map[string]types.Float64 `tfsdk:"fail_above_percent"`
+
+	// Computed
+	Plan        types.String             `tfsdk:"plan"`
+	Usage       map[string]types.Int64   `tfsdk:"usage"`
+	Quotas      map[string]types.Int64   `tfsdk:"quotas"`
+	PercentUsed map[string]types.Float64 `tfsdk:"percent_used"`
+}
+
+func (d *OrganizationUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_usage"
+}
+
+func (d *OrganizationUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	dimensions := "`" + strings.Join(usageDimensions, "`, `") + "`"
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Organization Usage data source. Combines the current usage of an organization with the quotas of its plan. " +
+			"Every map is keyed by usage dimension: " + dimensions + ". " +
+			"Data sources are read while planning, so `fail_above_percent` can be used to block applies once a quota is nearly exhausted.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_name": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization.",
+				Required:            true,
+			},
+			"fail_above_percent": schema.MapAttribute{
+				MarkdownDescription: "Thresholds, in percent of the quota, keyed by usage dimension. Reading the data source fails if the usage of any dimension is above its threshold, " +
+					"if a dimension with a quota of 0 has any usage, or if a dimension with a threshold has no known quota, such as an unlimited one.",
+				ElementType: types.Float64Type,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(usageDimensions...)),
+					mapvalidator.ValueFloat64sAre(float64validator.AtLeast(0)),
+				},
+			},
+			"plan": schema.StringAttribute{
+				MarkdownDescription: "The name of the plan the organization is subscribed to.",
+				Computed:            true,
+			},
+			"usage": schema.MapAttribute{
+				MarkdownDescription: "The usage of the current billing cycle. Storage and bytes synced are in bytes.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"quotas": schema.MapAttribute{
+				MarkdownDescription: "The quotas of the plan. Unlimited dimensions are omitted.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"percent_used": schema.MapAttribute{
+				MarkdownDescription: "The usage in percent of the quota. Unlimited dimensions are omitted.",
+				ElementType:         types.Float64Type,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *OrganizationUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationUsageDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slug := data.OrganizationName.ValueString()
+
+	organizationRes, err := d.client.GetOrganization(ctx, client.GetOrganizationParams{
+		OrganizationSlug: slug,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization, got error: %s", err.Error()))
+		return
+	}
+
+	var planId string
+
+	switch p := organizationRes.(type) {
+	case *client.GetOrganizationOK:
+		planId = p.Organization.Value.PlanID.Value
+	case *client.GetOrganizationNotFound:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization, got error: %s", p.Error.Value))
+		return
+	}
+
+	usageRes, err := d.client.GetOrganizationUsage(ctx, client.GetOrganizationUsageParams{
+		OrganizationSlug: slug,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization usage, got error: %s", err.Error()))
+		return
+	}
+
+	plansRes, err := d.client.ListOrganizationPlans(ctx, client.ListOrganizationPlansParams{
+		OrganizationSlug: slug,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list organization plans, got error: %s", err.Error()))
+		return
+	}
+
+	usage := usageRes.Organization.Value.Usage.Value
+	current := map[string]client.OptInt{
+		"rows_read":     usage.RowsRead,
+		"rows_written":  usage.RowsWritten,
+		"databases":     usage.Databases,
+		"locations":     usage.Locations,
+		"storage_bytes": usage.StorageBytes,
+		"groups":        usage.Groups,
+		"bytes_synced":  usage.BytesSynced,
+	}
+
+	data.Plan = types.StringValue(planId)
+	data.Usage = map[string]types.Int64{}
+	data.Quotas = map[string]types.Int64{}
+	data.PercentUsed = map[string]types.Float64{}
+
+	for dimension, value := range current {
+		data.Usage[dimension] = types.Int64Value(int64(value.Value))
+	}
+
+	var plan *client.OrganizationPlan
+	for i := range plansRes.Plans {
+		if strings.EqualFold(plansRes.Plans[i].Name.Value, planId) {
+			plan = &plansRes.Plans[i]
+			break
+		}
+	}
+
+	// Quotas stay nil when the plan is unknown, so that no threshold passes
+	// unchecked.
+	var quotas map[string]int
+
+	if plan == nil {
+		tflog.Warn(ctx, fmt.Sprintf("Plan %s of organization %s not found, quotas are unknown", planId, slug))
+	} else {
+		data.Plan = types.StringValue(plan.Name.Value)
+		quotas = planQuotas(plan.Quotas.Value)
+
+		for dimension, quota := range quotas {
+			data.Quotas[dimension] = types.Int64Value(int64(quota))
+
+			if quota > 0 {
+				data.PercentUsed[dimension] = types.Float64Value(float64(current[dimension].Value) * 100 / float64(quota))
+			}
+		}
+	}
+
+	thresholds := map[string]float64{}
+	for dimension, threshold := range data.FailAbovePercent {
+		if !threshold.IsNull() && !threshold.IsUnknown() {
+			thresholds[dimension] = threshold.ValueFloat64()
+		}
+	}
+
+	usageValues := map[string]int64{}
+	for dimension, value := range data.Usage {
+		usageValues[dimension] = value.ValueInt64()
+	}
+
+	exceeded, unchecked := checkUsageThresholds(thresholds, usageValues, quotas)
+
+	if len(unchecked) > 0 {
+		resp.Diagnostics.AddError(
+			"Unable to Check Quota Threshold",
+			fmt.Sprintf("Organization %s has no known quota for %s, so fail_above_percent cannot be checked for it. "+
+				"Remove the threshold for unlimited dimensions.", slug, strings.Join(unchecked, ", ")),
+		)
+	}
+
+	if len(exceeded) > 0 {
+		resp.Diagnostics.AddError(
+			"Quota Threshold Exceeded",
+			fmt.Sprintf("Organization %s is above the configured usage thresholds:\n  - %s", slug, strings.Join(exceeded, "\n  - ")),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// checkUsageThresholds compares usage with thresholds, in percent of quotas.
+// It returns a description of every dimension above its threshold, and the
+// dimensions whose threshold cannot be checked because they have no known
+// limited quota. Any usage of a quota of 0 exceeds every threshold.
+func checkUsageThresholds(thresholds map[string]float64, usage map[string]int64, quotas map[string]int) (exceeded, unchecked []string) {
+	for _, dimension := range usageDimensions {
+		threshold, ok := thresholds[dimension]
+		if !ok {
+			continue
+		}
+
+		quota, ok := quotas[dimension]
+
+		switch {
+		case !ok || quota < 0:
+			unchecked = append(unchecked, dimension)
+		case quota == 0:
+			if usage[dimension] > 0 {
+				exceeded = append(exceeded, fmt.Sprintf("%s is at %d with a quota of 0 (threshold %.2f%%)", dimension, usage[dimension], threshold))
+			}
+		default:
+			percent := float64(usage[dimension]) * 100 / float64(quota)
+			if percent > threshold {
+				exceeded = append(exceeded, fmt.Sprintf("%s is at %.2f%% of its quota (threshold %.2f%%)", dimension, percent, threshold))
+			}
+		}
+	}
+
+	return exceeded, unchecked
+}
+
+// planQuotas returns the quotas of a plan keyed by usage dimension. Unlimited
+// dimensions are omitted.
+func planQuotas(quotas client.PlanQuotas) map[string]int {
+	res := map[string]int{}
+
+	set := func(dimension string, quota client.OptInt) {
+		if quota.Set {
+			res[dimension] = quota.Value
+		}
+	}
+
+	set("rows_read", quotas.RowsRead)
+	set("rows_written", quotas.RowsWritten)
+	set("locations", quotas.Locations)
+	set("storage_bytes", quotas.Storage)
+	set("groups", quotas.Groups)
+	set("bytes_synced", quotas.BytesSynced)
+
+	if quotas.Databases.Set && !quotas.Databases.Null {
+		res["databases"] = quotas.Databases.Value
+	}
+
+	return res
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"regexp"
+	"terraform-provider-turso/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationUsageDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+				data "turso_organization_usage" "test" {
+					organization_name = "jpedroh"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.turso_organization_usage.test", "plan"),
+					resource.TestCheckResourceAttrSet("data.turso_organization_usage.test", "usage.databases"),
+					resource.TestCheckResourceAttrSet("data.turso_organization_usage.test", "quotas.rows_read"),
+					resource.TestCheckResourceAttrSet("data.turso_organization_usage.test", "percent_used.rows_read"),
+				),
+			},
+		},
+	})
+}
+
+func TestOrganizationUsageDataSourceThresholds(t *testing.T) {
+	config, handler, _ := newFaultyProviderConfig(t, 0)
+
+	err := handler.SetDatabaseUsage("jpedroh", "tfproviderdatasource", client.DatabaseUsageObject{
+		RowsRead:     client.NewOptInt(1_000_000),
+		RowsWritten:  client.NewOptInt(20_000_000),
+		StorageBytes: client.NewOptInt(4_500_000_000),
+		BytesSynced:  client.NewOptInt(0),
+	})
+	if err != nil {
+		t.Fatalf("unable to seed usage: %s", err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				data "turso_organization_usage" "test" {
+					organization_name  = "jpedroh"
+					fail_above_percent = {
+						rows_deleted = 75
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`rows_deleted`),
+			},
+			{
+				Config: config + `
+				data "turso_organization_usage" "test" {
+					organization_name  = "jpedroh"
+					fail_above_percent = {
+						rows_read = 50
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.turso_organization_usage.test", "plan", "starter"),
+					resource.TestCheckResourceAttr("data.turso_organization_usage.test", "usage.rows_written", "20000000"),
+					resource.TestCheckResourceAttr("data.turso_organization_usage.test", "usage.databases", "1"),
+					resource.TestCheckResourceAttr("data.turso_organization_usage.test", "quotas.rows_written", "25000000"),
+					resource.TestCheckResourceAttr("data.turso_organization_usage.test", "percent_used.rows_written", "80"),
+					resource.TestCheckResourceAttr("data.turso_organization_usage.test", "percent_used.storage_bytes", "50"),
+				),
+			},
+			{
+				Config: config + `
+				data "turso_organization_usage" "test" {
+					organization_name  = "jpedroh"
+					fail_above_percent = {
+						rows_written  = 75
+						storage_bytes = 50
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`rows_written is at 80.00% of its quota \(threshold 75.00%\)`),
+			},
+		},
+	})
+}
+
+func TestOrganizationUsageDataSourceUnknownPlan(t *testing.T) {
+	config, handler, _ := newFaultyProviderConfig(t, 0)
+
+	if err := handler.SetOrganizationPlan("jpedroh", "legacy"); err != nil {
+		t.Fatalf("unable to set plan: %s", err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+				data "turso_organization_usage" "test" {
+					organization_name  = "jpedroh"
+					fail_above_percent = {
+						rows_read = 50
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`Organization jpedroh has no known quota for rows_read`),
+			},
+			{
+				Config: config + `
+				data "turso_organization_usage" "test" {
+					organization_name = "jpedroh"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.turso_organization_usage.test", "plan", "legacy"),
+					resource.TestCheckResourceAttr("data.turso_organization_usage.test", "quotas.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestCheckUsageThresholds(t *testing.T) {
+	thresholds := map[string]float64{"rows_read": 50, "rows_written": 50, "databases": 90, "groups": 10, "locations": 10}
+	usage := map[string]int64{"rows_read": 60, "rows_written": 40, "databases": 3, "groups": 1, "locations": 0}
+	quotas := map[string]int{"rows_read": 100, "rows_written": 100, "groups": 0, "locations": 0}
+
+	exceeded, unchecked := checkUsageThresholds(thresholds, usage, quotas)
+
+	wantExceeded := []string{
+		"rows_read is at 60.00% of its quota (threshold 50.00%)",
+		"groups is at 1 with a quota of 0 (threshold 10.00%)",
+	}
+	if !reflect.DeepEqual(exceeded, wantExceeded) {
+		t.Errorf("exceeded = %q, want %q", exceeded, wantExceeded)
+	}
+
+	if want := []string{"databases"}; !reflect.DeepEqual(unchecked, want) {
+		t.Errorf("unchecked = %q, want %q", unchecked, want)
+	}
+
+	// Without a known plan, no threshold can be checked.
+	_, unchecked = checkUsageThresholds(map[string]float64{"rows_read": 50}, usage, nil)
+	if want := []string{"rows_read"}; !reflect.DeepEqual(unchecked, want) {
+		t.Errorf("unchecked without quotas = %q, want %q", unchecked, want)
+	}
+}
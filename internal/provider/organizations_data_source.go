@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"terraform-provider-turso/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &OrganizationsDataSource{}
+
+func NewOrganizationsDataSource() datasource.DataSource {
+	return &OrganizationsDataSource{}
+}
+
+// OrganizationsDataSource defines the data source implementation.
+type OrganizationsDataSource struct {
+	client *client.Client
+}
+
+// OrganizationsDataSourceModel describes the data source data model.
+type OrganizationsDataSourceModel struct {
+	// Computed
+	Organizations []OrganizationSummaryModel `tfsdk:"organizations"`
+}
+
+type OrganizationSummaryModel struct {
+	Slug   types.String `tfsdk:"slug"`
+	Name   types.String `tfsdk:"name"`
+	Type   types.String `tfsdk:"type"`
+	PlanId types.String `tfsdk:"plan_id"`
+}
+
+func (d *OrganizationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organizations"
+}
+
+func (d *OrganizationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Organizations data source. Lists every organization the API token can access.",
+
+		Attributes: map[string]schema.Attribute{
+			"organizations": schema.ListNestedAttribute{
+				MarkdownDescription: "The organizations, sorted by slug.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"slug": schema.StringAttribute{
+							MarkdownDescription: "The organization slug. This will be your username for `personal` accounts.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The organization name.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of account this organization is. Will always be `personal` or `team`.",
+							Computed:            true,
+						},
+						"plan_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the plan the organization is subscribed to.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrganizationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationsDataSourceModel
+
+	res, err := d.client.ListOrganizations(ctx)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list organizations, got error: %s", err.Error()))
+		return
+	}
+
+	data.Organizations = []OrganizationSummaryModel{}
+	for _, organization := range res {
+		data.Organizations = append(data.Organizations, OrganizationSummaryModel{
+			Slug:   types.StringValue(organization.Slug.Value),
+			Name:   types.StringValue(organization.Name.Value),
+			Type:   types.StringValue(string(organization.Type.Value)),
+			PlanId: types.StringValue(organization.PlanID.Value),
+		})
+	}
+
+	sort.Slice(data.Organizations, func(i, j int) bool {
+		return data.Organizations[i].Slug.ValueString() < data.Organizations[j].Slug.ValueString()
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseLibsqlUrlFunction{}
+
+// libsqlUrlSchemes are the URL schemes that libSQL clients accept.
+var libsqlUrlSchemes = map[string]bool{"libsql": true, "https": true, "http": true, "wss": true, "ws": true}
+
+var parsedLibsqlUrlAttrTypes = map[string]attr.Type{
+	"scheme":     types.StringType,
+	"hostname":   types.StringType,
+	"auth_token": types.StringType,
+	"http_url":   types.StringType,
+}
+
+func NewParseLibsqlUrlFunction() function.Function {
+	return &ParseLibsqlUrlFunction{}
+}
+
+// ParseLibsqlUrlFunction defines the function implementation.
+type ParseLibsqlUrlFunction struct{}
+
+func (f *ParseLibsqlUrlFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_libsql_url"
+}
+
+func (f *ParseLibsqlUrlFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a libSQL connection URL",
+		MarkdownDescription: "Parses a connection URL such as `libsql://<hostname>?authToken=<token>` into an object with the `scheme`, " +
+			"`hostname` (including the port, if any), `auth_token` (null if the URL has none) and `http_url` of the database.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: "The URL to parse. The `libsql`, `https`, `http`, `wss` and `ws` schemes are accepted.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parsedLibsqlUrlAttrTypes,
+		},
+	}
+}
+
+func (f *ParseLibsqlUrlFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var raw string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &raw))
+
+	if resp.Error != nil {
+		return
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to parse URL: %s", err.Error()))
+		return
+	}
+
+	if !libsqlUrlSchemes[u.Scheme] {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unsupported URL scheme %q, expected one of libsql, https, http, wss or ws", u.Scheme))
+		return
+	}
+
+	if u.Host == "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("The URL %q has no hostname", raw))
+		return
+	}
+
+	authToken := types.StringNull()
+	if token := u.Query().Get("authToken"); token != "" {
+		authToken = types.StringValue(token)
+	}
+
+	// Plain HTTP is kept for local servers, everything else is served over
+	// HTTPS.
+	httpScheme := "https"
+	if u.Scheme == "http" || u.Scheme == "ws" {
+		httpScheme = "http"
+	}
+	httpUrl := url.URL{Scheme: httpScheme, Host: u.Host}
+
+	result, diags := types.ObjectValue(parsedLibsqlUrlAttrTypes, map[string]attr.Value{
+		"scheme":     types.StringValue(u.Scheme),
+		"hostname":   types.StringValue(u.Host),
+		"auth_token": authToken,
+		"http_url":   types.StringValue(httpUrl.String()),
+	})
+
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseLibsqlUrlFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				locals {
+					parsed = provider::turso::parse_libsql_url("libsql://db-org.aws-us-east-1.turso.io?authToken=ey.J%2B")
+					local  = provider::turso::parse_libsql_url("ws://127.0.0.1:8080")
+				}
+
+				output "scheme" {
+					value = local.parsed.scheme
+				}
+
+				output "hostname" {
+					value = local.parsed.hostname
+				}
+
+				output "auth_token" {
+					value = local.parsed.auth_token
+				}
+
+				output "http_url" {
+					value = local.parsed.http_url
+				}
+
+				output "local_hostname" {
+					value = local.local.hostname
+				}
+
+				output "local_has_auth_token" {
+					value = local.local.auth_token != null
+				}
+
+				output "local_http_url" {
+					value = local.local.http_url
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("scheme", "libsql"),
+					resource.TestCheckOutput("hostname", "db-org.aws-us-east-1.turso.io"),
+					resource.TestCheckOutput("auth_token", "ey.J+"),
+					resource.TestCheckOutput("http_url", "https://db-org.aws-us-east-1.turso.io"),
+					resource.TestCheckOutput("local_hostname", "127.0.0.1:8080"),
+					resource.TestCheckOutput("local_has_auth_token", "false"),
+					resource.TestCheckOutput("local_http_url", "http://127.0.0.1:8080"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseLibsqlUrlFunctionInvalidScheme(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::turso::parse_libsql_url("postgres://db-org.aws-us-east-1.turso.io")
+				}`,
+				ExpectError: regexp.MustCompile(`Unsupported URL scheme "postgres"`),
+			},
+		},
+	})
+}
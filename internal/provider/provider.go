@@ -7,20 +7,30 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
 	"terraform-provider-turso/internal/client"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure TursoProvider satisfies various provider interfaces.
 var _ provider.Provider = &TursoProvider{}
 var _ provider.ProviderWithFunctions = &TursoProvider{}
+var _ provider.ProviderWithEphemeralResources = &TursoProvider{}
+
+const (
+	defaultBaseUrl        = "https://api.turso.tech"
+	defaultRequestTimeout = 2 * time.Minute
+)
 
 // TursoProvider defines the provider implementation.
 type TursoProvider struct {
@@ -32,7 +42,12 @@ type TursoProvider struct {
 
 // TursoProviderModel describes the provider data model.
 type TursoProviderModel struct {
-	ApiToken types.String `tfsdk:"api_token"`
+	ApiToken       types.String `tfsdk:"api_token"`
+	MaxRetries     types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait   types.Int64  `tfsdk:"retry_max_wait"`
+	RequestTimeout types.Int64  `tfsdk:"request_timeout"`
+	OtlpEndpoint   types.String `tfsdk:"otlp_endpoint"`
+	BaseUrl        types.String `tfsdk:"base_url"`
 }
 
 func (p *TursoProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -47,6 +62,35 @@ func (p *TursoProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				MarkdownDescription: "The API token to authenticate with Turso API",
 				Required:            true,
 			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of times a request that failed with a transient error (network error, 429 or 5xx) is retried. Only idempotent requests are retried. Set to 0 to disable retries. Defaults to %d.", defaultMaxRetries),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum time in seconds to wait between two retries, including waits requested by the `Retry-After` header. Defaults to %d.", int64(defaultRetryMaxWait/time.Second)),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum time in seconds a single API call may take, including its retries. Defaults to %d.", int64(defaultRequestTimeout/time.Second)),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Base URL of the Turso Platform API. Defaults to `%s`.", defaultBaseUrl),
+				Optional:            true,
+			},
+			"otlp_endpoint": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("OTLP/HTTP endpoint (e.g. `http://localhost:4318`) to export traces and metrics of Turso API calls to. Can also be set with the `%s` environment variable. Telemetry is disabled when unset.", otlpEndpointEnvVar),
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -54,7 +98,6 @@ func (p *TursoProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 type AuthenticationRoundTripper struct {
 	Token   string
 	Proxied http.RoundTripper
-	Diag    diag.Diagnostics
 }
 
 func (lrt AuthenticationRoundTripper) RoundTrip(req *http.Request) (res *http.Response, e error) {
@@ -71,22 +114,70 @@ func (p *TursoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
+	maxRetries := defaultMaxRetries
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	retryMaxWait := defaultRetryMaxWait
+	if !config.RetryMaxWait.IsNull() {
+		retryMaxWait = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+	}
+
+	requestTimeout := defaultRequestTimeout
+	if !config.RequestTimeout.IsNull() {
+		requestTimeout = time.Duration(config.RequestTimeout.ValueInt64()) * time.Second
+	}
+
+	baseUrl := defaultBaseUrl
+	if !config.BaseUrl.IsNull() {
+		baseUrl = config.BaseUrl.ValueString()
+	}
+
+	otlpEndpoint := os.Getenv(otlpEndpointEnvVar)
+	if !config.OtlpEndpoint.IsNull() {
+		otlpEndpoint = config.OtlpEndpoint.ValueString()
+	}
+
+	var transport http.RoundTripper = AuthenticationRoundTripper{
+		Token: config.ApiToken.ValueString(),
+		Proxied: RetryRoundTripper{
+			Proxied: LoggingRoundTripper{
+				Proxied: http.DefaultTransport,
+			},
+			MaxRetries: maxRetries,
+			MinWait:    defaultRetryMinWait,
+			MaxWait:    retryMaxWait,
+		},
+	}
+
+	var clientOptions []client.ClientOption
+
+	if otlpEndpoint != "" {
+		tracerProvider, meterProvider, err := newTelemetryProviders(ctx, otlpEndpoint, p.version)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to configure telemetry", err.Error())
+			return
+		}
+
+		transport = TelemetryRoundTripper{Proxied: transport}
+		clientOptions = append(clientOptions, client.WithTracerProvider(tracerProvider), client.WithMeterProvider(meterProvider))
+	}
+
 	// Example client configuration for data sources and resources
 	httpClient := &http.Client{
-		Transport: AuthenticationRoundTripper{
-			Token:   config.ApiToken.ValueString(),
-			Proxied: http.DefaultTransport,
-			Diag:    resp.Diagnostics,
-		},
+		Timeout:   requestTimeout,
+		Transport: transport,
 	}
 
-	client, err := client.NewClient("https://api.turso.tech", client.WithClient(httpClient))
+	client, err := client.NewClient(baseUrl, append(clientOptions, client.WithClient(httpClient))...)
 	if err != nil {
 		resp.Diagnostics.AddError(err.Error(), err.Error())
 		return
 	}
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *TursoProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -94,6 +185,18 @@ func (p *TursoProvider) Resources(ctx context.Context) []func() resource.Resourc
 		NewDatabaseResource,
 		NewDatabaseTokenResource,
 		NewDatabaseConfigurationResource,
+		NewOrganizationMemberResource,
+		NewOrganizationInviteResource,
+		NewOrganizationMembersResource,
+		NewApiTokenResource,
+		NewOrganizationResource,
+	}
+}
+
+func (p *TursoProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewDatabaseTokenEphemeralResource,
+		NewGroupTokenEphemeralResource,
 	}
 }
 
@@ -103,11 +206,25 @@ func (p *TursoProvider) DataSources(ctx context.Context) []func() datasource.Dat
 		NewOrganizationDataSource,
 		NewDatabaseConfigurationDataSource,
 		NewDatabaseInstanceDataSource,
+		NewOrganizationMembersDataSource,
+		NewOrganizationInvitesDataSource,
+		NewOrganizationsDataSource,
+		NewOrganizationUsageDataSource,
+		NewOrganizationSubscriptionDataSource,
+		NewOrganizationPlansDataSource,
+		NewOrganizationInvoicesDataSource,
+		NewDatabaseUsageDataSource,
+		NewDatabaseStatsDataSource,
+		NewOrganizationAuditLogsDataSource,
 	}
 }
 
 func (p *TursoProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewLibsqlUrlFunction,
+		NewHttpUrlFunction,
+		NewParseLibsqlUrlFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -5,21 +5,129 @@ package provider
 
 import (
 	"fmt"
+	"log"
 	"os"
+	"terraform-provider-turso/internal/client"
+	"terraform-provider-turso/internal/fakeapi"
+	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
-var providerConfig = fmt.Sprintf(`
-provider "turso" {
-  api_token = "%s"
-}
-`, os.Getenv("TURSO_API_TOKEN"))
+// liveApiEnvVar makes acceptance tests run against the real Turso API, using
+// the TURSO_API_TOKEN environment variable. By default they run against an
+// in-memory fake of the API.
+const liveApiEnvVar = "TURSO_ACC_LIVE"
+
+const fakeApiToken = "fake-api-token"
+
+// providerConfig is set by TestMain to target either the fake or the live API.
+var providerConfig string
 
 var (
 	testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 		"turso": providerserver.NewProtocol6WithError(New("test")()),
 	}
+
+	// testAccProtoV6ProviderFactoriesWithEcho adds the echo provider, whose
+	// echo resource stores the data it is configured with so that tests can
+	// check ephemeral values.
+	testAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+		"turso": providerserver.NewProtocol6WithError(New("test")()),
+		"echo":  echoprovider.NewProviderServer(),
+	}
 )
+
+func TestMain(m *testing.M) {
+	if os.Getenv(liveApiEnvVar) != "" {
+		providerConfig = fmt.Sprintf(`
+provider "turso" {
+  api_token = "%s"
+}
+`, os.Getenv("TURSO_API_TOKEN"))
+
+		os.Exit(m.Run())
+	}
+
+	handler, err := newFakeApiHandler()
+	if err != nil {
+		log.Fatalf("unable to seed fake API: %s", err)
+	}
+
+	server, err := fakeapi.NewServer(handler, fakeApiToken)
+	if err != nil {
+		log.Fatalf("unable to start fake API: %s", err)
+	}
+
+	providerConfig = fmt.Sprintf(`
+provider "turso" {
+  api_token = "%s"
+  base_url  = "%s"
+}
+`, fakeApiToken, server.URL)
+
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
+
+// newFakeApiHandler seeds the fake API with the fixtures the acceptance tests
+// expect to already exist in the live test account.
+func newFakeApiHandler() (*fakeapi.Handler, error) {
+	handler := fakeapi.NewHandler()
+	handler.AddOrganization("jpedroh", "jpedroh", client.OrganizationTypePersonal)
+	handler.AddUser("tfprovidermember", "tfprovidermember@example.com")
+	handler.AddUser("tfprovideradmin", "tfprovideradmin@example.com")
+
+	if err := handler.AddMember("jpedroh", "jpedroh", client.MemberRoleOwner); err != nil {
+		return nil, err
+	}
+
+	if err := handler.AddGroup("jpedroh", "default", "aws-us-east-1"); err != nil {
+		return nil, err
+	}
+
+	err := handler.AddDatabase("jpedroh", "tfproviderdatasource", "default", client.DatabaseConfigurationResponse{
+		SizeLimit:        client.NewOptString("1gb"),
+		AllowAttach:      client.NewOptBool(false),
+		BlockReads:       client.NewOptBool(true),
+		BlockWrites:      client.NewOptBool(true),
+		DeleteProtection: client.NewOptBool(false),
+	})
+
+	return handler, err
+}
+
+// newFaultyProviderConfig starts a dedicated fake API, seeded like the shared
+// one, whose operations can be made to misbehave through the returned Faults.
+// It is used even in live mode. The returned provider configuration retries
+// failed requests up to maxRetries times, waiting at most a second in between.
+func newFaultyProviderConfig(t *testing.T, maxRetries int) (string, *fakeapi.Handler, *fakeapi.Faults) {
+	t.Helper()
+
+	handler, err := newFakeApiHandler()
+	if err != nil {
+		t.Fatalf("unable to seed fake API: %s", err)
+	}
+
+	faults := &fakeapi.Faults{}
+	server, err := fakeapi.NewServer(handler, fakeApiToken, client.WithMiddleware(faults.Middleware()))
+	if err != nil {
+		t.Fatalf("unable to start fake API: %s", err)
+	}
+	t.Cleanup(server.Close)
+
+	config := fmt.Sprintf(`
+provider "turso" {
+  api_token      = "%s"
+  base_url       = "%s"
+  max_retries    = %d
+  retry_max_wait = 1
+}
+`, fakeApiToken, server.URL, maxRetries)
+
+	return config, handler, faults
+}
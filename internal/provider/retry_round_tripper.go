@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMaxRetries   = 3
+	defaultRetryMinWait = 1 * time.Second
+	defaultRetryMaxWait = 30 * time.Second
+)
+
+// RetryRoundTripper retries requests that failed with a transient error
+// (network errors, 429 and 5xx responses) using exponential backoff with
+// full jitter. A Retry-After header sent by the server takes precedence over
+// the computed backoff, capped at MaxWait.
+type RetryRoundTripper struct {
+	Proxied    http.RoundTripper
+	MaxRetries int
+	MinWait    time.Duration
+	MaxWait    time.Duration
+}
+
+func (rrt RetryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if rrt.MaxRetries <= 0 || !isRetryableRequest(req) {
+		return rrt.Proxied.RoundTrip(req)
+	}
+
+	for attempt := 0; ; attempt++ {
+		// A RoundTripper must not modify the request, so every attempt sends a
+		// clone with a fresh copy of the body.
+		attemptReq := req.Clone(req.Context())
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq.Body = body
+		}
+
+		res, err := rrt.Proxied.RoundTrip(attemptReq)
+
+		if attempt >= rrt.MaxRetries || !shouldRetry(res, err) {
+			return res, err
+		}
+
+		wait := rrt.backoff(attempt, res)
+
+		if res != nil {
+			// Drain the body so the underlying connection can be reused.
+			_, _ = io.Copy(io.Discard, res.Body)
+			_ = res.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns how long to wait before the next attempt.
+func (rrt RetryRoundTripper) backoff(attempt int, res *http.Response) time.Duration {
+	minWait, maxWait := rrt.MinWait, rrt.MaxWait
+	if minWait <= 0 {
+		minWait = defaultRetryMinWait
+	}
+	if maxWait <= 0 {
+		maxWait = defaultRetryMaxWait
+	}
+
+	if res != nil {
+		if wait, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			return min(wait, maxWait)
+		}
+	}
+
+	wait := minWait << attempt
+	if wait <= 0 || wait > maxWait {
+		wait = maxWait
+	}
+
+	return rand.N(wait) + 1
+}
+
+// isRetryableRequest reports whether replaying req cannot cause duplicate side
+// effects. Idempotent methods are always retryable and PATCH is only retried
+// for configuration updates. POST is never retried: a replayed token mint
+// creates a second token, and a replayed rotation invalidates the tokens of
+// the first one.
+func isRetryableRequest(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPatch:
+		return strings.HasSuffix(req.URL.Path, "/configuration")
+	}
+
+	return false
+}
+
+func shouldRetry(res *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	return res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500 && res.StatusCode != http.StatusNotImplemented
+}
+
+// parseRetryAfter parses a Retry-After header, which is either a number of
+// seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
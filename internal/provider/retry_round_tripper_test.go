@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestRetryClient(maxRetries int) *http.Client {
+	return &http.Client{
+		Transport: RetryRoundTripper{
+			Proxied:    http.DefaultTransport,
+			MaxRetries: maxRetries,
+			MinWait:    time.Millisecond,
+			MaxWait:    10 * time.Millisecond,
+		},
+	}
+}
+
+// newFlakyServer returns a server that replies with status for the first
+// failures requests and with 200 afterwards.
+func newFlakyServer(t *testing.T, failures int32, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if calls.Add(1) <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			return
+		}
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func TestRetryRoundTripperRetriesTransientErrors(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable} {
+		server, calls := newFlakyServer(t, 2, status, nil)
+
+		res, err := newTestRetryClient(3).Get(server.URL)
+		if err != nil {
+			t.Fatalf("status %d: unexpected error: %s", status, err)
+		}
+		res.Body.Close()
+
+		if res.StatusCode != http.StatusOK {
+			t.Errorf("status %d: expected 200, got %d", status, res.StatusCode)
+		}
+		if calls.Load() != 3 {
+			t.Errorf("status %d: expected 3 calls, got %d", status, calls.Load())
+		}
+	}
+}
+
+func TestRetryRoundTripperGivesUpAfterMaxRetries(t *testing.T) {
+	server, calls := newFlakyServer(t, 10, http.StatusInternalServerError, nil)
+
+	res, err := newTestRetryClient(2).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected 500, got %d", res.StatusCode)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 calls, got %d", calls.Load())
+	}
+}
+
+func TestRetryRoundTripperDoesNotRetryClientErrors(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, http.StatusNotFound, nil)
+
+	res, err := newTestRetryClient(3).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusNotFound {
+		t.Errorf("expected 404, got %d", res.StatusCode)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 call, got %d", calls.Load())
+	}
+}
+
+func TestRetryRoundTripperDoesNotRetryUnsafePost(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, http.StatusServiceUnavailable, nil)
+
+	res, err := newTestRetryClient(3).Post(server.URL+"/v1/organizations/acme/databases", "application/json", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected 503, got %d", res.StatusCode)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 call, got %d", calls.Load())
+	}
+}
+
+func TestRetryRoundTripperDoesNotRetryTokenMints(t *testing.T) {
+	for _, path := range []string{"/v1/organizations/acme/databases/db/auth/tokens", "/v1/organizations/acme/databases/db/auth/rotate"} {
+		server, calls := newFlakyServer(t, 1, http.StatusServiceUnavailable, nil)
+
+		res, err := newTestRetryClient(3).Post(server.URL+path, "application/json", strings.NewReader(`{}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		res.Body.Close()
+
+		if calls.Load() != 1 {
+			t.Errorf("%s: expected 1 call, got %d", path, calls.Load())
+		}
+	}
+}
+
+func TestRetryRoundTripperReplaysSafePatchBody(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, http.StatusServiceUnavailable, nil)
+
+	req, err := http.NewRequest(http.MethodPatch, server.URL+"/v1/organizations/acme/databases/db/configuration", strings.NewReader(`{"size_limit":"1gb"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	body := req.Body
+
+	res, err := newTestRetryClient(3).Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	replayed, _ := io.ReadAll(res.Body)
+	res.Body.Close()
+
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 calls, got %d", calls.Load())
+	}
+	if string(replayed) != `{"size_limit":"1gb"}` {
+		t.Errorf("expected request body to be replayed, got %q", replayed)
+	}
+	if req.Body != body {
+		t.Errorf("expected the caller's request to be left untouched")
+	}
+}
+
+func TestRetryRoundTripperCapsRetryAfter(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"3600"}})
+
+	start := time.Now()
+	res, err := newTestRetryClient(3).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	res.Body.Close()
+
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 calls, got %d", calls.Load())
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected Retry-After to be capped by MaxWait, waited %s", elapsed)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if wait, ok := parseRetryAfter("5"); !ok || wait != 5*time.Second {
+		t.Errorf("expected 5s, got %s (%t)", wait, ok)
+	}
+
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if wait, ok := parseRetryAfter(date); !ok || wait <= 0 || wait > time.Minute {
+		t.Errorf("expected a wait of up to one minute, got %s (%t)", wait, ok)
+	}
+
+	for _, value := range []string{"", "soon", "-1"} {
+		if _, ok := parseRetryAfter(value); ok {
+			t.Errorf("expected %q to be rejected", value)
+		}
+	}
+}
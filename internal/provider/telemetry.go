@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// otlpEndpointEnvVar enables OTLP export when the otlp_endpoint provider
+// attribute is not set.
+const otlpEndpointEnvVar = "TURSO_OTLP_ENDPOINT"
+
+var (
+	telemetryShutdownsMu sync.Mutex
+	telemetryShutdowns   []func(context.Context) error
+)
+
+// newTelemetryProviders creates tracer and meter providers exporting over
+// OTLP/HTTP to endpoint. They are flushed by ShutdownTelemetry.
+func newTelemetryProviders(ctx context.Context, endpoint, version string) (*sdktrace.TracerProvider, *sdkmetric.MeterProvider, error) {
+	res := sdkresource.NewSchemaless(
+		attribute.String("service.name", "terraform-provider-turso"),
+		attribute.String("service.version", version),
+	)
+
+	traceExporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metricExporter, err := otlpmetrichttp.New(ctx, otlpmetrichttp.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(traceExporter),
+		sdktrace.WithResource(res),
+	)
+	meterProvider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter)),
+		sdkmetric.WithResource(res),
+	)
+
+	telemetryShutdownsMu.Lock()
+	telemetryShutdowns = append(telemetryShutdowns, tracerProvider.Shutdown, meterProvider.Shutdown)
+	telemetryShutdownsMu.Unlock()
+
+	return tracerProvider, meterProvider, nil
+}
+
+// ShutdownTelemetry flushes and stops every telemetry provider created by
+// configured providers. It is a no-op when telemetry is disabled.
+func ShutdownTelemetry(ctx context.Context) error {
+	telemetryShutdownsMu.Lock()
+	shutdowns := telemetryShutdowns
+	telemetryShutdowns = nil
+	telemetryShutdownsMu.Unlock()
+
+	var errs []error
+	for _, shutdown := range shutdowns {
+		errs = append(errs, shutdown(ctx))
+	}
+
+	return errors.Join(errs...)
+}
+
+// TelemetryRoundTripper adds the organization, database and group targeted
+// by a request to the span of the client operation that sent it.
+type TelemetryRoundTripper struct {
+	Proxied http.RoundTripper
+}
+
+func (trt TelemetryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if span := trace.SpanFromContext(req.Context()); span.IsRecording() {
+		span.SetAttributes(apiPathAttributes(req.URL.Path)...)
+	}
+
+	return trt.Proxied.RoundTrip(req)
+}
+
+// apiPathAttributes extracts span attributes from a Turso API path such as
+// /v1/organizations/{organizationSlug}/databases/{databaseName}.
+func apiPathAttributes(path string) []attribute.KeyValue {
+	keys := map[string]string{
+		"organizations": "turso.organization",
+		"databases":     "turso.database",
+		"groups":        "turso.group",
+	}
+
+	var attrs []attribute.KeyValue
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	for i := 0; i+1 < len(segments); i++ {
+		if key, ok := keys[segments[i]]; ok {
+			attrs = append(attrs, attribute.String(key, segments[i+1]))
+			i++
+		}
+	}
+
+	return attrs
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"terraform-provider-turso/internal/client"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestApiPathAttributes(t *testing.T) {
+	cases := map[string][]attribute.KeyValue{
+		"/v1/organizations": nil,
+		"/v1/organizations/acme": {
+			attribute.String("turso.organization", "acme"),
+		},
+		"/v1/organizations/acme/databases/db/auth/tokens": {
+			attribute.String("turso.organization", "acme"),
+			attribute.String("turso.database", "db"),
+		},
+		"/v1/organizations/acme/groups/default/locations/lhr": {
+			attribute.String("turso.organization", "acme"),
+			attribute.String("turso.group", "default"),
+		},
+	}
+
+	for path, expected := range cases {
+		actual := apiPathAttributes(path)
+		if len(actual) != len(expected) {
+			t.Errorf("%s: expected %v, got %v", path, expected, actual)
+			continue
+		}
+		for i := range expected {
+			if actual[i] != expected[i] {
+				t.Errorf("%s: expected %v, got %v", path, expected, actual)
+			}
+		}
+	}
+}
+
+func TestTelemetryRoundTripperAnnotatesOperationSpans(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"database":{"Name":"db"}}`))
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	c, err := client.NewClient(server.URL,
+		client.WithTracerProvider(tracerProvider),
+		client.WithClient(&http.Client{Transport: TelemetryRoundTripper{Proxied: http.DefaultTransport}}),
+	)
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	if _, err := c.GetDatabase(context.Background(), client.GetDatabaseParams{OrganizationSlug: "acme", DatabaseName: "db"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+
+	if spans[0].Name() != client.GetDatabaseOperation {
+		t.Errorf("expected span %s, got %s", client.GetDatabaseOperation, spans[0].Name())
+	}
+
+	attrs := map[attribute.Key]string{}
+	for _, attr := range spans[0].Attributes() {
+		attrs[attr.Key] = attr.Value.Emit()
+	}
+
+	if attrs["turso.organization"] != "acme" || attrs["turso.database"] != "db" {
+		t.Errorf("expected organization and database attributes, got %v", attrs)
+	}
+}
@@ -4,8 +4,19 @@
 package provider
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
 	"strings"
+	"terraform-provider-turso/internal/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/ogen-go/ogen/validate"
 )
 
 func ExtractDbIdFromImportStateId(id string) (string, string, error) {
@@ -15,3 +26,170 @@ func ExtractDbIdFromImportStateId(id string) (string, string, error) {
 	}
 	return parts[0], parts[1], nil
 }
+
+// IsNotFoundError reports whether err is a 404 response for an operation whose
+// specification does not model it, such as GetDatabaseConfiguration.
+func IsNotFoundError(err error) bool {
+	var statusErr *validate.UnexpectedStatusCodeError
+	return errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound
+}
+
+// ParseAmount parses a formatted USD amount such as "10.29" or "$1,024.00".
+// An empty amount is reported as ok == false.
+func ParseAmount(amount string) (value float64, ok bool, err error) {
+	amount = strings.TrimSpace(amount)
+	amount = strings.TrimPrefix(amount, "$")
+	amount = strings.ReplaceAll(amount, ",", "")
+
+	if amount == "" {
+		return 0, false, nil
+	}
+
+	value, err = strconv.ParseFloat(amount, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("%q is not a valid amount", amount)
+	}
+
+	return value, true, nil
+}
+
+// databaseSetting is a database setting as last applied by a resource and as
+// currently reported by the API.
+type databaseSetting struct {
+	name    string
+	applied attr.Value
+	current attr.Value
+}
+
+// changedDatabaseSettings returns the names of the settings whose current value
+// differs from the one last applied. Settings that were never applied are
+// skipped.
+func changedDatabaseSettings(settings []databaseSetting) []string {
+	var changed []string
+	for _, setting := range settings {
+		if setting.applied.IsNull() || setting.applied.IsUnknown() {
+			continue
+		}
+
+		if !setting.applied.Equal(setting.current) {
+			changed = append(changed, setting.name)
+		}
+	}
+
+	return changed
+}
+
+// conflictingDatabaseSettingsWarning reports settings that were changed since
+// resourceType last applied them, such as by the other resource managing the
+// settings of the same database.
+func conflictingDatabaseSettingsWarning(resourceType, organizationSlug, databaseName string, changed []string) diag.Diagnostic {
+	return diag.NewWarningDiagnostic(
+		"Conflicting Database Settings",
+		fmt.Sprintf("The %s of %s/%s changed since %s last applied them. "+
+			"They were changed outside of Terraform or by another resource; turso_database and turso_database_configuration "+
+			"must not both manage the same database, as they overwrite each other.",
+			strings.Join(changed, ", "), organizationSlug, databaseName, resourceType),
+	)
+}
+
+// TokenClaims are the claims of a Turso database or group token.
+type TokenClaims struct {
+	// Access is "ro" for read-only tokens.
+	Access    string `json:"a"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+	ID        string `json:"id"`
+}
+
+// Authorization returns the authorization level of the token, as accepted by
+// CreateDatabaseToken.
+func (c TokenClaims) Authorization() string {
+	if c.Access == "ro" {
+		return string(client.CreateDatabaseTokenAuthorizationReadOnly)
+	}
+	return string(client.CreateDatabaseTokenAuthorizationFullAccess)
+}
+
+// Expiration returns the lifetime of the token in the format accepted by
+// CreateDatabaseToken, or an empty string if the token never expires.
+func (c TokenClaims) Expiration() string {
+	if c.ExpiresAt == 0 || c.ExpiresAt <= c.IssuedAt {
+		return ""
+	}
+	return FormatTokenExpiration(time.Duration(c.ExpiresAt-c.IssuedAt) * time.Second)
+}
+
+// DecodeTokenClaims decodes the claims of a JWT without verifying its
+// signature.
+func DecodeTokenClaims(jwt string) (TokenClaims, error) {
+	var claims TokenClaims
+
+	parts := strings.Split(strings.TrimSpace(jwt), ".")
+	if len(parts) != 3 {
+		return claims, errors.New("the token is not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return claims, fmt.Errorf("the token payload is not valid base64: %w", err)
+	}
+
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return claims, fmt.Errorf("the token payload is not valid JSON: %w", err)
+	}
+
+	return claims, nil
+}
+
+var tokenExpirationUnits = []struct {
+	unit     byte
+	duration time.Duration
+}{
+	{'w', 7 * 24 * time.Hour},
+	{'d', 24 * time.Hour},
+	{'h', time.Hour},
+	{'m', time.Minute},
+	{'s', time.Second},
+}
+
+// ParseTokenExpiration parses token expirations such as 2w1d30m.
+func ParseTokenExpiration(expiration string) (time.Duration, error) {
+	var total time.Duration
+	start := 0
+
+	for i := 0; i < len(expiration); i++ {
+		for _, u := range tokenExpirationUnits {
+			if expiration[i] != u.unit {
+				continue
+			}
+
+			value, err := strconv.Atoi(expiration[start:i])
+			if err != nil {
+				return 0, fmt.Errorf("%q is not a valid expiration", expiration)
+			}
+
+			total += time.Duration(value) * u.duration
+			start = i + 1
+		}
+	}
+
+	if start != len(expiration) || total <= 0 {
+		return 0, fmt.Errorf("%q is not a valid expiration", expiration)
+	}
+
+	return total, nil
+}
+
+// FormatTokenExpiration formats d like 2w1d30m, using the largest units first.
+func FormatTokenExpiration(d time.Duration) string {
+	var b strings.Builder
+
+	for _, u := range tokenExpirationUnits {
+		if n := d / u.duration; n > 0 {
+			fmt.Fprintf(&b, "%d%c", n, u.unit)
+			d -= n * u.duration
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		amount  string
+		value   float64
+		ok      bool
+		wantErr bool
+	}{
+		{amount: "10.29", value: 10.29, ok: true},
+		{amount: "0", value: 0, ok: true},
+		{amount: "$1,024.50", value: 1024.5, ok: true},
+		{amount: " 29 ", value: 29, ok: true},
+		{amount: ""},
+		{amount: "ten", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		value, ok, err := ParseAmount(tt.amount)
+
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseAmount(%q) error = %v, wantErr %v", tt.amount, err, tt.wantErr)
+			continue
+		}
+
+		if value != tt.value || ok != tt.ok {
+			t.Errorf("ParseAmount(%q) = %v, %v, want %v, %v", tt.amount, value, ok, tt.value, tt.ok)
+		}
+	}
+}
+
+func TestChangedDatabaseSettings(t *testing.T) {
+	changed := changedDatabaseSettings([]databaseSetting{
+		{"delete_protection", types.BoolValue(true), types.BoolValue(true)},
+		{"block_reads", types.BoolNull(), types.BoolValue(true)},
+		{"block_writes", types.BoolValue(false), types.BoolValue(true)},
+		{"size_limit", types.StringValue("1mb"), types.StringValue("2mb")},
+	})
+
+	if expected := []string{"block_writes", "size_limit"}; !reflect.DeepEqual(changed, expected) {
+		t.Errorf("expected %v, got %v", expected, changed)
+	}
+
+	if changed := changedDatabaseSettings([]databaseSetting{{"block_reads", types.BoolValue(true), types.BoolValue(true)}}); changed != nil {
+		t.Errorf("expected no changed settings, got %v", changed)
+	}
+}
+
+func TestDecodeTokenClaims(t *testing.T) {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"a":"ro","iat":1700000000,"exp":1700694800,"id":"db-id"}`))
+
+	claims, err := DecodeTokenClaims("header." + payload + ".signature")
+	if err != nil {
+		t.Fatalf("DecodeTokenClaims() error = %v", err)
+	}
+
+	if claims.ID != "db-id" {
+		t.Errorf("ID = %q, want %q", claims.ID, "db-id")
+	}
+
+	if got := claims.Authorization(); got != "read-only" {
+		t.Errorf("Authorization() = %q, want %q", got, "read-only")
+	}
+
+	if got := claims.Expiration(); got != "1w1d1h" {
+		t.Errorf("Expiration() = %q, want %q", got, "1w1d1h")
+	}
+
+	if got := (TokenClaims{Access: "rw"}).Authorization(); got != "full-access" {
+		t.Errorf("Authorization() = %q, want %q", got, "full-access")
+	}
+
+	if got := (TokenClaims{IssuedAt: 1700000000}).Expiration(); got != "" {
+		t.Errorf("Expiration() = %q, want no expiration", got)
+	}
+
+	for _, jwt := range []string{"", "not-a-jwt", "a.!!!.c", "a." + base64.RawURLEncoding.EncodeToString([]byte("[]")) + ".c"} {
+		if _, err := DecodeTokenClaims(jwt); err == nil {
+			t.Errorf("DecodeTokenClaims(%q) expected an error", jwt)
+		}
+	}
+}
+
+func TestTokenExpiration(t *testing.T) {
+	tests := []struct {
+		expiration string
+		duration   time.Duration
+		formatted  string
+	}{
+		{expiration: "1w", duration: 7 * 24 * time.Hour, formatted: "1w"},
+		{expiration: "7d", duration: 7 * 24 * time.Hour, formatted: "1w"},
+		{expiration: "2w1d30m", duration: 15*24*time.Hour + 30*time.Minute, formatted: "2w1d30m"},
+		{expiration: "90s", duration: 90 * time.Second, formatted: "1m30s"},
+	}
+
+	for _, tt := range tests {
+		duration, err := ParseTokenExpiration(tt.expiration)
+		if err != nil {
+			t.Errorf("ParseTokenExpiration(%q) error = %v", tt.expiration, err)
+			continue
+		}
+
+		if duration != tt.duration {
+			t.Errorf("ParseTokenExpiration(%q) = %v, want %v", tt.expiration, duration, tt.duration)
+		}
+
+		if formatted := FormatTokenExpiration(duration); formatted != tt.formatted {
+			t.Errorf("FormatTokenExpiration(%v) = %q, want %q", duration, formatted, tt.formatted)
+		}
+	}
+
+	for _, expiration := range []string{"", "never", "1", "w", "1x"} {
+		if _, err := ParseTokenExpiration(expiration); err == nil {
+			t.Errorf("ParseTokenExpiration(%q) expected an error", expiration)
+		}
+	}
+}
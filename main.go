@@ -47,6 +47,11 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
 
+	// Flush any traces and metrics buffered while serving.
+	if shutdownErr := provider.ShutdownTelemetry(context.Background()); shutdownErr != nil {
+		log.Printf("unable to flush telemetry: %s", shutdownErr.Error())
+	}
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}